@@ -231,6 +231,16 @@ type Scalar struct {
 
 	scalarConfig ScalarConfig
 	err          error
+
+	// parsesNull is set for scalars whose ParseValue turns a variable that
+	// was explicitly provided as null into a value, see newOptionalScalar.
+	parsesNull bool
+	// nullOnInvalid is set for scalars that treat values they cannot parse
+	// as null, see NullableScalar.
+	nullOnInvalid bool
+	// wrapped is the scalar a NullableScalar or NonNullScalar was created
+	// from.
+	wrapped *Scalar
 }
 
 // SerializeFn is a function type for serializing a GraphQLScalar type value
@@ -249,6 +259,10 @@ type ScalarConfig struct {
 	Serialize    SerializeFn
 	ParseValue   ParseValueFn
 	ParseLiteral ParseLiteralFn
+
+	// RedactInErrors leaves rejected values out of error messages, for
+	// scalars that carry sensitive data.
+	RedactInErrors bool
 }
 
 // NewScalar creates a new GraphQLScalar
@@ -290,6 +304,7 @@ func NewScalar(config ScalarConfig) *Scalar {
 		}
 	}
 
+	config.Serialize = serializeWithMetrics(config.Name, config.Serialize)
 	st.scalarConfig = config
 	return st
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/language/location"
 	"github.com/graphql-go/graphql/testutil"
 )
@@ -1743,3 +1744,226 @@ func TestContextDeadline(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
 	}
 }
+
+func TestReportsUnparsableScalarArgumentWithItsLocation(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"before": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						"at": &graphql.ArgumentConfig{
+							Type: graphql.NewDateTimeScalarLoc(time.UTC),
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return true, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	tests := []struct {
+		query     string
+		variables map[string]interface{}
+		expected  []gqlerrors.FormattedError
+	}{
+		{
+			query: `{
+      before(at: "not-a-date")
+    }`,
+			expected: []gqlerrors.FormattedError{
+				{
+					Message: "Argument \"at\" has invalid value \"not-a-date\".\n" +
+						`Expected type "DateTimeUTC", found "not-a-date".`,
+					Locations: []location.SourceLocation{
+						{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			query: `query ($at: DateTimeUTC) {
+      before(at: $at)
+    }`,
+			variables: map[string]interface{}{"at": "not-a-date"},
+			expected: []gqlerrors.FormattedError{
+				{
+					Message: "Variable \"$at\" got invalid value \"not-a-date\".\n" +
+						`Expected type "DateTimeUTC", found "not-a-date".`,
+					Locations: []location.SourceLocation{
+						{Line: 1, Column: 8},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  test.query,
+			VariableValues: test.variables,
+		})
+		if !reflect.DeepEqual(test.expected, result.Errors) {
+			t.Fatalf("Unexpected errors, Diff: %v", testutil.Diff(test.expected, result.Errors))
+		}
+	}
+}
+
+func TestRedactsRejectedValuesOfRedactedScalarsInErrors(t *testing.T) {
+	coerceSecret := func(value interface{}) interface{} {
+		if value == "open sesame" {
+			return value
+		}
+		return nil
+	}
+	secret := graphql.NewScalar(graphql.ScalarConfig{
+		Name:       "Secret",
+		Serialize:  coerceSecret,
+		ParseValue: coerceSecret,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			if valueAST, ok := valueAST.(*ast.StringValue); ok {
+				return coerceSecret(valueAST.Value)
+			}
+			return nil
+		},
+		RedactInErrors: true,
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"unlock": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						"secret": &graphql.ArgumentConfig{
+							Type: secret,
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return true, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ unlock(secret: "hunter2") }`,
+	})
+	expected := "Argument \"secret\" has invalid value <redacted>.\nExpected type \"Secret\", found <redacted>."
+	if len(result.Errors) != 1 || result.Errors[0].Message != expected {
+		t.Fatalf("Expected error %q, got: %v", expected, result.Errors)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($s: Secret) { unlock(secret: $s) }`,
+		VariableValues: map[string]interface{}{"s": "hunter2"},
+	})
+	expected = "Variable \"$s\" got invalid value <redacted>.\nExpected type \"Secret\", found <redacted>."
+	if len(result.Errors) != 1 || result.Errors[0].Message != expected {
+		t.Fatalf("Expected error %q, got: %v", expected, result.Errors)
+	}
+}
+
+func TestPassesDateTimeLiteralsToResolversUnparsed(t *testing.T) {
+	var received interface{}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"before": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						"at": &graphql.ArgumentConfig{
+							Type: graphql.DateTime,
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						received = p.Args["at"]
+						return true, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ before(at: "2017-07-23T03:46:56.647Z") }`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected := "2017-07-23T03:46:56.647Z"
+	if received != expected {
+		t.Fatalf("Expected %v, got: %v", expected, received)
+	}
+}
+
+func TestDropsUnparsableLiteralArgumentsWhenNotValidated(t *testing.T) {
+	doc := `{
+      a: echo(n: "one")
+      b: echo(n: 2)
+      c: echoWithDefault(n: "one")
+    }`
+
+	echo := func(p graphql.ResolveParams) (interface{}, error) {
+		return p.Args["n"], nil
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"echo": &graphql.Field{
+					Type: graphql.Int,
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: graphql.Int},
+					},
+					Resolve: echo,
+				},
+				"echoWithDefault": &graphql.Field{
+					Type: graphql.Int,
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 1},
+					},
+					Resolve: echo,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"a": nil,
+			"b": 2,
+			"c": 1,
+		},
+	}
+
+	// Execute skips validation, which would otherwise reject the literal.
+	ep := graphql.ExecuteParams{
+		Schema: schema,
+		AST:    testutil.TestParse(t, doc),
+	}
+	result := testutil.TestExecute(t, ep)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
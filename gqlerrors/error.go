@@ -1,6 +1,7 @@
 package gqlerrors
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -17,6 +18,8 @@ type Error struct {
 	Positions     []int
 	Locations     []location.SourceLocation
 	OriginalError error
+	Path          []interface{}
+	Extensions    map[string]interface{}
 }
 
 // implements Golang's built-in `error` interface
@@ -24,37 +27,52 @@ func (g Error) Error() string {
 	return fmt.Sprintf("%v", g.Message)
 }
 
+// ToMap returns the message of g along with its locations, path and
+// extensions, each omitted when empty, as a map that callers may reshape
+// before encoding it, for instance to promote an extension to the top level.
+func (g Error) ToMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"message": g.Message,
+	}
+	if len(g.Locations) > 0 {
+		m["locations"] = g.Locations
+	}
+	if len(g.Path) > 0 {
+		m["path"] = g.Path
+	}
+	if len(g.Extensions) > 0 {
+		m["extensions"] = g.Extensions
+	}
+	return m
+}
+
+// Unwrap returns the error that caused g, if any, so that g works with
+// errors.Is and errors.As on Go 1.13 and later.
+func (g Error) Unwrap() error {
+	return g.OriginalError
+}
+
+// AsError returns the original error that e was created from, or e itself
+// when it has none. A nil e yields a nil error.
+func AsError(e *Error) error {
+	if e == nil {
+		return nil
+	}
+	if e.OriginalError != nil {
+		return e.OriginalError
+	}
+	return e
+}
+
 func NewError(message string, nodes []ast.Node, stack string, source *source.Source, positions []int, origError error) *Error {
 	if stack == "" && message != "" {
 		stack = message
 	}
 	if source == nil {
-		for _, node := range nodes {
-			// get source from first node
-			if node == nil || reflect.ValueOf(node).IsNil() {
-				continue
-			}
-			if node.GetLoc() != nil {
-				source = node.GetLoc().Source
-			}
-			break
-		}
+		source = sourceOfNodes(nodes)
 	}
 	if len(positions) == 0 && len(nodes) > 0 {
-		for _, node := range nodes {
-			if node == nil || reflect.ValueOf(node).IsNil() {
-				continue
-			}
-			if node.GetLoc() == nil {
-				continue
-			}
-			positions = append(positions, node.GetLoc().Start)
-		}
-	}
-	locations := []location.SourceLocation{}
-	for _, pos := range positions {
-		loc := location.GetLocation(source, pos)
-		locations = append(locations, loc)
+		positions = positionsOfNodes(nodes)
 	}
 	return &Error{
 		Message:       message,
@@ -62,7 +80,91 @@ func NewError(message string, nodes []ast.Node, stack string, source *source.Sou
 		Nodes:         nodes,
 		Source:        source,
 		Positions:     positions,
-		Locations:     locations,
+		Locations:     locationsAt(source, positions),
 		OriginalError: origError,
 	}
 }
+
+// sourceOfNodes returns the source of the first non-nil node.
+func sourceOfNodes(nodes []ast.Node) *source.Source {
+	for _, node := range nodes {
+		// get source from first node
+		if node == nil || reflect.ValueOf(node).IsNil() {
+			continue
+		}
+		if node.GetLoc() != nil {
+			return node.GetLoc().Source
+		}
+		break
+	}
+	return nil
+}
+
+// positionsOfNodes returns the start offsets of the nodes that have a
+// location.
+func positionsOfNodes(nodes []ast.Node) []int {
+	positions := []int{}
+	for _, node := range nodes {
+		if node == nil || reflect.ValueOf(node).IsNil() {
+			continue
+		}
+		if node.GetLoc() == nil {
+			continue
+		}
+		positions = append(positions, node.GetLoc().Start)
+	}
+	return positions
+}
+
+// locationsAt converts byte offsets into src to 1-based lines and columns.
+func locationsAt(src *source.Source, positions []int) []location.SourceLocation {
+	locations := []location.SourceLocation{}
+	for _, pos := range positions {
+		locations = append(locations, location.GetLocation(src, pos))
+	}
+	return locations
+}
+
+// ResolveLocations recomputes the Positions and the 1-based line and column
+// Locations of g from its Nodes. It is meant for errors that were built by
+// hand or whose nodes were changed after creation. When g has no Source, the
+// source of its first node is used.
+func (g *Error) ResolveLocations() {
+	if g.Source == nil {
+		g.Source = sourceOfNodes(g.Nodes)
+	}
+	g.Positions = positionsOfNodes(g.Nodes)
+	g.Locations = locationsAt(g.Source, g.Positions)
+}
+
+// GroupErrorsBySource groups errs by the name of the source they were raised
+// against. Errors without a location are grouped under the empty name, and
+// nil entries are skipped.
+func GroupErrorsBySource(errs []*Error) map[string][]*Error {
+	groups := map[string][]*Error{}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		name := ""
+		if err.Source != nil && len(err.Locations) > 0 {
+			name = err.Source.Name
+		}
+		groups[name] = append(groups[name], err)
+	}
+	return groups
+}
+
+// ErrorsToJSON encodes errs as a GraphQL response "errors" array, with each
+// entry shaped as by ToMap. Nil entries are skipped, and no errors encode as
+// an empty array.
+func ErrorsToJSON(errs []*Error) ([]byte, error) {
+	entries := make([]map[string]interface{}, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		entries = append(entries, err.ToMap())
+	}
+	return json.Marshal(entries)
+}
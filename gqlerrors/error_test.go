@@ -0,0 +1,159 @@
+package gqlerrors_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+func TestGroupErrorsBySource(t *testing.T) {
+	queries := source.NewSource(&source.Source{Body: []byte("{ a }"), Name: "queries.graphql"})
+	mutations := source.NewSource(&source.Source{Body: []byte("{ b }"), Name: "mutations.graphql"})
+
+	errA := gqlerrors.NewError("a", nil, "", queries, []int{2}, nil)
+	errB := gqlerrors.NewError("b", nil, "", mutations, []int{2}, nil)
+	errC := gqlerrors.NewError("c", nil, "", queries, []int{2}, nil)
+	errD := gqlerrors.NewError("d", nil, "", nil, nil, nil)
+
+	expected := map[string][]*gqlerrors.Error{
+		"queries.graphql":   {errA, errC},
+		"mutations.graphql": {errB},
+		"":                  {errD},
+	}
+	result := gqlerrors.GroupErrorsBySource([]*gqlerrors.Error{errA, errB, errC, errD})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestGroupErrorsBySource_SkipsNilErrors(t *testing.T) {
+	err := gqlerrors.NewError("a", nil, "", nil, nil, nil)
+
+	expected := map[string][]*gqlerrors.Error{
+		"": {err},
+	}
+	result := gqlerrors.GroupErrorsBySource([]*gqlerrors.Error{nil, err, nil})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+type notFoundError struct {
+	ID string
+}
+
+func (e *notFoundError) Error() string {
+	return "not found: " + e.ID
+}
+
+func TestAsError_ReturnsOriginalError(t *testing.T) {
+	original := &notFoundError{ID: "1"}
+	err := gqlerrors.NewError(original.Error(), nil, "", nil, nil, original)
+
+	if result := gqlerrors.AsError(err); result != original {
+		t.Fatalf("Expected %v, got: %v", original, result)
+	}
+	if result := err.Unwrap(); result != original {
+		t.Fatalf("Expected Unwrap to return %v, got: %v", original, result)
+	}
+}
+
+func TestAsError_WithoutOriginalError(t *testing.T) {
+	err := gqlerrors.NewError("boom", nil, "", nil, nil, nil)
+	if result := gqlerrors.AsError(err); result != err {
+		t.Fatalf("Expected %v, got: %v", err, result)
+	}
+	if result := gqlerrors.AsError(nil); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestError_ToMapIncludesPopulatedFields(t *testing.T) {
+	s := source.NewSource(&source.Source{Body: []byte("{ a }")})
+	err := gqlerrors.NewError("boom", nil, "", s, []int{2}, nil)
+	err.Path = []interface{}{"a", 0}
+	err.Extensions = map[string]interface{}{"code": "INTERNAL"}
+
+	expected := map[string]interface{}{
+		"message":    "boom",
+		"locations":  []location.SourceLocation{{Line: 1, Column: 3}},
+		"path":       []interface{}{"a", 0},
+		"extensions": map[string]interface{}{"code": "INTERNAL"},
+	}
+	if result := err.ToMap(); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestError_ToMapOmitsEmptyFields(t *testing.T) {
+	err := gqlerrors.NewError("boom", nil, "", nil, nil, nil)
+	expected := map[string]interface{}{
+		"message": "boom",
+	}
+	if result := err.ToMap(); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestFormatError_KeepsExtensions(t *testing.T) {
+	err := gqlerrors.NewError("boom", nil, "", nil, nil, nil)
+	err.Extensions = map[string]interface{}{"code": "BOOM"}
+	expected := map[string]interface{}{"code": "BOOM"}
+	if result := gqlerrors.FormatError(err).Extensions; !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestError_ResolveLocationsOnLaterLine(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("query {\n  a\n  bad\n}")})
+	node := ast.NewName(&ast.Name{
+		Value: "bad",
+		Loc:   &ast.Location{Start: 14, End: 17, Source: src},
+	})
+	err := &gqlerrors.Error{
+		Message: "bad field",
+		Nodes:   []ast.Node{node},
+	}
+	err.ResolveLocations()
+
+	expected := []location.SourceLocation{{Line: 3, Column: 3}}
+	if !reflect.DeepEqual(expected, err.Locations) {
+		t.Fatalf("Expected %v, got: %v", expected, err.Locations)
+	}
+	if err.Source != src || !reflect.DeepEqual([]int{14}, err.Positions) {
+		t.Fatalf("Expected source and positions to be resolved, got: %v, %v", err.Source, err.Positions)
+	}
+}
+
+func TestErrorsToJSON(t *testing.T) {
+	s := source.NewSource(&source.Source{Body: []byte("{ a b }")})
+	located := gqlerrors.NewError("bad field", nil, "", s, []int{2, 4}, nil)
+	withPath := gqlerrors.NewError("resolver failed", nil, "", nil, nil, nil)
+	withPath.Path = []interface{}{"a", 1, "b"}
+	withPath.Extensions = map[string]interface{}{"code": "INTERNAL"}
+	bare := gqlerrors.NewError("boom", nil, "", nil, nil, nil)
+
+	result, err := gqlerrors.ErrorsToJSON([]*gqlerrors.Error{located, withPath, bare})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	expected := `[` +
+		`{"locations":[{"line":1,"column":3},{"line":1,"column":5}],"message":"bad field"},` +
+		`{"extensions":{"code":"INTERNAL"},"message":"resolver failed","path":["a",1,"b"]},` +
+		`{"message":"boom"}` +
+		`]`
+	if string(result) != expected {
+		t.Fatalf("Expected %v, got: %v", expected, string(result))
+	}
+}
+
+func TestErrorsToJSON_EmptyArray(t *testing.T) {
+	result, err := gqlerrors.ErrorsToJSON(nil)
+	if err != nil || string(result) != "[]" {
+		t.Fatalf("Expected [], got: %v, %v", string(result), err)
+	}
+}
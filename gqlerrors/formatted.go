@@ -7,8 +7,9 @@ import (
 )
 
 type FormattedError struct {
-	Message   string                    `json:"message"`
-	Locations []location.SourceLocation `json:"locations"`
+	Message    string                    `json:"message"`
+	Locations  []location.SourceLocation `json:"locations"`
+	Extensions map[string]interface{}    `json:"extensions,omitempty"`
 }
 
 func (g FormattedError) Error() string {
@@ -26,13 +27,15 @@ func FormatError(err error) FormattedError {
 		return err
 	case *Error:
 		return FormattedError{
-			Message:   err.Error(),
-			Locations: err.Locations,
+			Message:    err.Error(),
+			Locations:  err.Locations,
+			Extensions: err.Extensions,
 		}
 	case Error:
 		return FormattedError{
-			Message:   err.Error(),
-			Locations: err.Locations,
+			Message:    err.Error(),
+			Locations:  err.Locations,
+			Extensions: err.Extensions,
 		}
 	default:
 		return FormattedError{
@@ -1,6 +1,10 @@
 package ast
 
 import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/graphql-go/graphql/language/source"
 )
 
@@ -20,3 +24,69 @@ func NewLocation(loc *Location) *Location {
 		Source: loc.Source,
 	}
 }
+
+// Clone returns a copy of l that can be mutated without affecting l. The
+// copy shares l's Source, since sources are treated as immutable and are
+// usually shared by every location parsed from them.
+func (l Location) Clone() Location {
+	return l
+}
+
+// CloneWithSource returns a copy of l that points into src instead of l's
+// source, for instance when a node is moved to another document.
+func (l Location) CloneWithSource(src *source.Source) Location {
+	l.Source = src
+	return l
+}
+
+// Contains reports whether offset falls within l, which spans the half-open
+// range [Start, End).
+func (l Location) Contains(offset int) bool {
+	return l.Start <= offset && offset < l.End
+}
+
+// Overlaps reports whether l and other share at least one offset. Only the
+// offsets are compared; callers must ensure both locations refer to the same
+// source.
+func (l Location) Overlaps(other Location) bool {
+	return l.Start < other.End && other.Start < l.End
+}
+
+// CaretUnderline returns the source line containing Start, along with a line
+// that has carets under the part of it spanned by l, for use in diagnostics:
+//
+//	{ field(arg: "value") }
+//	             ^^^^^^^
+//
+// A span that continues onto further lines is only underlined up to the end
+// of its first line. Both strings are empty when l has no source.
+func (l Location) CaretUnderline() (lineText, caretLine string) {
+	if l.Source == nil || l.Start < 0 || l.Start > len(l.Source.Body) {
+		return "", ""
+	}
+	body := l.Source.Body
+	lineStart := bytes.LastIndexAny(body[:l.Start], "\r\n") + 1
+	lineEnd := len(body)
+	if i := bytes.IndexAny(body[l.Start:], "\r\n"); i >= 0 {
+		lineEnd = l.Start + i
+	}
+	end := l.End
+	if end > lineEnd {
+		end = lineEnd
+	}
+	if end < l.Start {
+		end = l.Start
+	}
+	carets := utf8.RuneCount(body[l.Start:end])
+	if carets < 1 {
+		carets = 1
+	}
+	// Tabs are kept so that the carets line up with the text above them.
+	indent := strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, string(body[lineStart:l.Start]))
+	return string(body[lineStart:lineEnd]), indent + strings.Repeat("^", carets)
+}
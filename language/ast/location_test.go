@@ -0,0 +1,102 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+func TestLocation_CloneIsIndependent(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("{ a }")})
+	original := ast.Location{Start: 2, End: 3, Source: src}
+
+	clone := original.Clone()
+	clone.Start = 0
+	if original.Start != 2 {
+		t.Fatalf("Expected original start to remain 2, got: %v", original.Start)
+	}
+	if clone.Source != original.Source {
+		t.Fatalf("Expected clone to share the original source")
+	}
+}
+
+func TestLocation_CloneWithSource(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("{ a }")})
+	other := source.NewSource(&source.Source{Body: []byte("{ b }"), Name: "other"})
+	original := ast.Location{Start: 2, End: 3, Source: src}
+
+	clone := original.CloneWithSource(other)
+	if clone.Source != other || clone.Start != 2 || clone.End != 3 {
+		t.Fatalf("Expected location 2-3 in other source, got: %+v", clone)
+	}
+	if original.Source != src {
+		t.Fatalf("Expected original source to be unchanged")
+	}
+}
+
+func TestLocation_ContainsBoundaries(t *testing.T) {
+	loc := ast.Location{Start: 2, End: 5}
+	for offset, expected := range map[int]bool{1: false, 2: true, 4: true, 5: false} {
+		if result := loc.Contains(offset); result != expected {
+			t.Fatalf("Expected Contains(%v) to be %v, got: %v", offset, expected, result)
+		}
+	}
+}
+
+func TestLocation_OverlapsBoundaries(t *testing.T) {
+	loc := ast.Location{Start: 2, End: 5}
+	for _, test := range []struct {
+		other    ast.Location
+		expected bool
+	}{
+		{ast.Location{Start: 0, End: 2}, false},
+		{ast.Location{Start: 0, End: 3}, true},
+		{ast.Location{Start: 3, End: 4}, true},
+		{ast.Location{Start: 4, End: 8}, true},
+		{ast.Location{Start: 5, End: 8}, false},
+	} {
+		if result := loc.Overlaps(test.other); result != test.expected {
+			t.Fatalf("Expected Overlaps(%+v) to be %v, got: %v", test.other, test.expected, result)
+		}
+	}
+}
+
+func TestLocation_CaretUnderlineSingleLine(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("query {\n  field(arg: \"value\")\n}")})
+	loc := ast.Location{Start: 21, End: 28, Source: src}
+
+	lineText, caretLine := loc.CaretUnderline()
+	if lineText != `  field(arg: "value")` {
+		t.Fatalf("Expected the second line, got: %q", lineText)
+	}
+	if caretLine != "             ^^^^^^^" {
+		t.Fatalf("Expected carets under the string, got: %q", caretLine)
+	}
+}
+
+func TestLocation_CaretUnderlineMultiLine(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("query {\n  field\n}")})
+	loc := ast.Location{Start: 6, End: 17, Source: src}
+
+	lineText, caretLine := loc.CaretUnderline()
+	if lineText != "query {" {
+		t.Fatalf("Expected the first line, got: %q", lineText)
+	}
+	if caretLine != "      ^" {
+		t.Fatalf("Expected carets up to the end of the first line, got: %q", caretLine)
+	}
+}
+
+func TestLocation_CaretUnderlineEndBeforeStart(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("query { field }")})
+	loc := ast.Location{Start: 5, End: 0, Source: src}
+
+	lineText, caretLine := loc.CaretUnderline()
+	if lineText != "query { field }" {
+		t.Fatalf("Expected the line, got: %q", lineText)
+	}
+	if caretLine != "     ^" {
+		t.Fatalf("Expected a single caret at the start, got: %q", caretLine)
+	}
+}
@@ -2,10 +2,38 @@ package graphql
 
 import (
 	"errors"
+	"runtime"
+	"strings"
+
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
 )
 
+var debugStackTraces bool
+
+// SetDebugStackTraces controls whether NewLocatedError records the stack of
+// the calling goroutine in the "stacktrace" extension of the error, next to
+// any extensions it already carries. It is meant for development only and is
+// off by default.
+func SetDebugStackTraces(enabled bool) {
+	debugStackTraces = enabled
+}
+
+// debugStack returns the stack of the calling goroutine from the caller of
+// NewLocatedError on, leaving out the goroutine header and the frames of
+// NewLocatedError itself, which are the same for every error.
+func debugStack() string {
+	buf := make([]byte, 64<<10)
+	buf = buf[:runtime.Stack(buf, false)]
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	for i, line := range lines {
+		if strings.Contains(line, "graphql.NewLocatedError(") && i+2 <= len(lines) {
+			return strings.Join(lines[i+2:], "\n")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 func NewLocatedError(err interface{}, nodes []ast.Node) *gqlerrors.Error {
 	var origError error
 	message := "An unknown error occurred."
@@ -18,7 +46,7 @@ func NewLocatedError(err interface{}, nodes []ast.Node) *gqlerrors.Error {
 		origError = errors.New(err)
 	}
 	stack := message
-	return gqlerrors.NewError(
+	locatedErr := gqlerrors.NewError(
 		message,
 		nodes,
 		stack,
@@ -26,6 +54,19 @@ func NewLocatedError(err interface{}, nodes []ast.Node) *gqlerrors.Error {
 		[]int{},
 		origError,
 	)
+	if err, ok := err.(*gqlerrors.Error); ok && len(err.Extensions) > 0 {
+		locatedErr.Extensions = map[string]interface{}{}
+		for key, value := range err.Extensions {
+			locatedErr.Extensions[key] = value
+		}
+	}
+	if debugStackTraces {
+		if locatedErr.Extensions == nil {
+			locatedErr.Extensions = map[string]interface{}{}
+		}
+		locatedErr.Extensions["stacktrace"] = debugStack()
+	}
+	return locatedErr
 }
 
 func FieldASTsToNodeASTs(fieldASTs []*ast.Field) []ast.Node {
@@ -0,0 +1,82 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+func stackTraceTestSchema(t *testing.T) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"boom": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						panic("boom")
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	return schema
+}
+
+func TestNewLocatedError_OmitsStackTraceByDefault(t *testing.T) {
+	result := graphql.Do(graphql.Params{
+		Schema:        stackTraceTestSchema(t),
+		RequestString: `{ boom }`,
+	})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected one error, got: %v", result.Errors)
+	}
+	if _, ok := result.Errors[0].Extensions["stacktrace"]; ok {
+		t.Fatalf("Expected no stacktrace extension, got: %v", result.Errors[0].Extensions)
+	}
+}
+
+func TestNewLocatedError_AttachesStackTraceInDebugMode(t *testing.T) {
+	graphql.SetDebugStackTraces(true)
+	defer graphql.SetDebugStackTraces(false)
+
+	result := graphql.Do(graphql.Params{
+		Schema:        stackTraceTestSchema(t),
+		RequestString: `{ boom }`,
+	})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected one error, got: %v", result.Errors)
+	}
+	stack, _ := result.Errors[0].Extensions["stacktrace"].(string)
+	if !strings.Contains(stack, "stackTraceTestSchema") {
+		t.Fatalf("Expected stacktrace to contain the panicking resolver, got: %q", stack)
+	}
+	if strings.HasPrefix(stack, "goroutine ") || strings.Contains(stack, "NewLocatedError") {
+		t.Fatalf("Expected stacktrace to start at the caller of NewLocatedError, got: %q", stack)
+	}
+}
+
+func TestNewLocatedError_KeepsExtensionsInDebugMode(t *testing.T) {
+	graphql.SetDebugStackTraces(true)
+	defer graphql.SetDebugStackTraces(false)
+
+	original := &gqlerrors.Error{
+		Message:    "not found",
+		Extensions: map[string]interface{}{"code": "NOT_FOUND"},
+	}
+	err := graphql.NewLocatedError(original, nil)
+	if err.Extensions["code"] != "NOT_FOUND" {
+		t.Fatalf("Expected the code extension to be kept, got: %v", err.Extensions)
+	}
+	if _, ok := err.Extensions["stacktrace"].(string); !ok {
+		t.Fatalf("Expected a stacktrace extension, got: %v", err.Extensions)
+	}
+	if _, ok := original.Extensions["stacktrace"]; ok {
+		t.Fatalf("Expected the original extensions to be left alone, got: %v", original.Extensions)
+	}
+}
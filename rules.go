@@ -62,6 +62,39 @@ func reportError(context *ValidationContext, message string, nodes []ast.Node) (
 	return visitor.ActionNoChange, nil
 }
 
+// reportInvalidValueError reports an error for an input value of the wrong
+// type, followed by messages describing each invalid value within it. Every
+// message, or the error itself when it has none, counts towards the limit set
+// by SetMaxScalarErrors. Messages past the limit are dropped, and a single
+// error noting that further values are omitted is reported at the value where
+// the limit was exceeded.
+func reportInvalidValueError(context *ValidationContext, message string, messages []string, nodes []ast.Node) (string, interface{}) {
+	count := len(messages)
+	if count == 0 {
+		count = 1
+	}
+	if maxScalarErrors > 0 && context.invalidValueCount+count > maxScalarErrors {
+		if remaining := maxScalarErrors - context.invalidValueCount; remaining > 0 {
+			reportError(context, message+"\n"+strings.Join(messages[:remaining], "\n"), nodes)
+		}
+		context.invalidValueCount = maxScalarErrors
+		if !context.tooManyInvalidValues {
+			context.tooManyInvalidValues = true
+			reportError(
+				context,
+				fmt.Sprintf(`Too many invalid values, only the first %v are reported.`, maxScalarErrors),
+				nodes,
+			)
+		}
+		return visitor.ActionNoChange, nil
+	}
+	context.invalidValueCount += count
+	if len(messages) > 0 {
+		message += "\n" + strings.Join(messages, "\n")
+	}
+	return reportError(context, message, nodes)
+}
+
 // ArgumentsOfCorrectTypeRule Argument values of correct type
 //
 // A GraphQL document is only valid if all field argument literal values are
@@ -82,14 +115,11 @@ func ArgumentsOfCorrectTypeRule(context *ValidationContext) *ValidationRuleInsta
 									argNameValue = argAST.Name.Value
 								}
 
-								messagesStr := ""
-								if len(messages) > 0 {
-									messagesStr = "\n" + strings.Join(messages, "\n")
-								}
-								reportError(
+								reportInvalidValueError(
 									context,
-									fmt.Sprintf(`Argument "%v" has invalid value %v.%v`,
-										argNameValue, printer.Print(value), messagesStr),
+									fmt.Sprintf(`Argument "%v" has invalid value %v.`,
+										argNameValue, printValueForError(argDef.Type, value)),
+									messages,
 									[]ast.Node{value},
 								)
 							}
@@ -133,14 +163,11 @@ func DefaultValuesOfCorrectTypeRule(context *ValidationContext) *ValidationRuleI
 						}
 						isValid, messages := isValidLiteralValue(ttype, defaultValue)
 						if ttype != nil && defaultValue != nil && !isValid {
-							messagesStr := ""
-							if len(messages) > 0 {
-								messagesStr = "\n" + strings.Join(messages, "\n")
-							}
-							reportError(
+							reportInvalidValueError(
 								context,
-								fmt.Sprintf(`Variable "$%v" has invalid default value: %v.%v`,
-									name, printer.Print(defaultValue), messagesStr),
+								fmt.Sprintf(`Variable "$%v" has invalid default value: %v.`,
+									name, printValueForError(ttype, defaultValue)),
+								messages,
 								[]ast.Node{defaultValue},
 							)
 						}
@@ -1738,6 +1765,12 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 			}
 			return false, []string{"Expected non-null value, found null."}
 		}
+		// Literals that a NullableScalar would turn into null are invalid
+		// where null is not allowed.
+		if scalar, ok := ttype.OfType.(*Scalar); ok && scalar.nullOnInvalid &&
+			valueAST.GetKind() != kinds.Variable && isNullish(scalar.ParseLiteral(valueAST)) {
+			return false, []string{invalidValueMessage(scalar, valueAST)}
+		}
 		ofType, _ := ttype.OfType.(Input)
 		return isValidLiteralValue(ofType, valueAST)
 	}
@@ -1762,6 +1795,9 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 				for idx, message := range messages {
 					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, idx+1, message))
 				}
+				if scalarErrorsExceeded(messagesReduce) {
+					break
+				}
 			}
 			return (len(messagesReduce) == 0), messagesReduce
 		}
@@ -1810,14 +1846,14 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 		return (len(messagesReduce) == 0), messagesReduce
 	}
 
-	if ttype, ok := ttype.(*Scalar); ok {
+	if ttype, ok := ttype.(*Scalar); ok && !ttype.nullOnInvalid {
 		if isNullish(ttype.ParseLiteral(valueAST)) {
-			return false, []string{fmt.Sprintf(`Expected type "%v", found %v.`, ttype.Name(), printer.Print(valueAST))}
+			return false, []string{invalidValueMessage(ttype, valueAST)}
 		}
 	}
 	if ttype, ok := ttype.(*Enum); ok {
 		if isNullish(ttype.ParseLiteral(valueAST)) {
-			return false, []string{fmt.Sprintf(`Expected type "%v", found %v.`, ttype.Name(), printer.Print(valueAST))}
+			return false, []string{invalidValueMessage(ttype, valueAST)}
 		}
 	}
 
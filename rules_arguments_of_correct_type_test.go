@@ -264,20 +264,14 @@ func TestValidate_ArgValuesOfCorrectType_InvalidFloatValues_UnquotedIntoFloat(t
 		})
 }
 
-func TestValidate_ArgValuesOfCorrectType_InvalidBooleanValues_IntIntoBoolean(t *testing.T) {
-	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+func TestValidate_ArgValuesOfCorrectType_ValidBooleanValues_IntIntoBoolean(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
         {
           complicatedArgs {
             booleanArgField(booleanArg: 2)
           }
         }
-        `,
-		[]gqlerrors.FormattedError{
-			testutil.RuleError(
-				"Argument \"booleanArg\" has invalid value 2.\nExpected type \"Boolean\", found 2.",
-				4, 41,
-			),
-		})
+        `)
 }
 func TestValidate_ArgValuesOfCorrectType_InvalidBooleanValues_FloatIntoBoolean(t *testing.T) {
 	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
@@ -294,20 +288,14 @@ func TestValidate_ArgValuesOfCorrectType_InvalidBooleanValues_FloatIntoBoolean(t
 			),
 		})
 }
-func TestValidate_ArgValuesOfCorrectType_InvalidBooleanValues_StringIntoBoolean(t *testing.T) {
-	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+func TestValidate_ArgValuesOfCorrectType_ValidBooleanValues_StringIntoBoolean(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
         {
           complicatedArgs {
             booleanArgField(booleanArg: "true")
           }
         }
-        `,
-		[]gqlerrors.FormattedError{
-			testutil.RuleError(
-				"Argument \"booleanArg\" has invalid value \"true\".\nExpected type \"Boolean\", found \"true\".",
-				4, 41,
-			),
-		})
+        `)
 }
 func TestValidate_ArgValuesOfCorrectType_InvalidBooleanValues_UnquotedStringIntoBoolean(t *testing.T) {
 	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
@@ -781,15 +769,15 @@ func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_WithDirectivesOfVali
 func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_WithDirectivesWithIncorrectTypes(t *testing.T) {
 	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
         {
-          dog @include(if: "yes") {
+          dog @include(if: 1.5) {
             name @skip(if: ENUM)
           }
         }
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				`Argument "if" has invalid value "yes".`+
-					"\nExpected type \"Boolean\", found \"yes\".",
+				`Argument "if" has invalid value 1.5.`+
+					"\nExpected type \"Boolean\", found 1.5.",
 				3, 28,
 			),
 			testutil.RuleError(
@@ -1,108 +1,226 @@
 package graphql
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
 )
 
+var scalarMetricsHook func(scalarName string, failed bool)
+
+// SetScalarMetricsHook registers a function that is called every time a
+// scalar created with NewScalar, built-in or not, serializes a value, with
+// failed set when the serialization produced nil. Passing nil removes the hook, which is the
+// default. The hook is not synchronized and should be set before any
+// queries are executed.
+func SetScalarMetricsHook(hook func(scalarName string, failed bool)) {
+	scalarMetricsHook = hook
+}
+
+// serializeWithMetrics wraps a scalar's serialize function so that its
+// outcome is reported to the scalar metrics hook, if any.
+func serializeWithMetrics(scalarName string, serialize SerializeFn) SerializeFn {
+	return func(value interface{}) interface{} {
+		result := serialize(value)
+		if hook := scalarMetricsHook; hook != nil {
+			hook(scalarName, result == nil)
+		}
+		return result
+	}
+}
+
+// typeNameFragment turns values into a fragment of a GraphQL type name, for
+// scalar constructors that name their scalars after their configuration. It
+// keeps the ASCII letters and digits of each value, starting each run of them
+// with an uppercase letter, so that `image/svg+xml` becomes `ImageSvgXml`.
+// Characters beyond ASCII are spelled out as code points, such as `U00ED`.
+func typeNameFragment(values ...string) string {
+	fragment := ""
+	for _, value := range values {
+		startOfWord := true
+		for _, r := range value {
+			switch {
+			case 'a' <= r && r <= 'z':
+				if startOfWord {
+					r -= 'a' - 'A'
+				}
+			case 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+			case r < utf8.RuneSelf:
+				startOfWord = true
+				continue
+			default:
+				fragment += fmt.Sprintf("U%04X", r)
+				startOfWord = true
+				continue
+			}
+			fragment += string(r)
+			startOfWord = false
+		}
+	}
+	return fragment
+}
+
+var typeCoercions = map[reflect.Type]func(interface{}) interface{}{}
+
+// RegisterTypeCoercion teaches the built-in Int, Float, String, Boolean and
+// ID coercers to handle values of type t, by converting them with coerce
+// into a value the coercer already supports. It is only consulted for types
+// the coercers do not otherwise handle, and coerce must not return another
+// value of type t. Like SetScalarMetricsHook, it is not synchronized and
+// should be called before any queries are executed.
+func RegisterTypeCoercion(t reflect.Type, coerce func(interface{}) interface{}) {
+	typeCoercions[t] = coerce
+}
+
+// UnregisterTypeCoercion removes the coercion registered for values of type
+// t, if any, so that the built-in coercers treat them as before.
+func UnregisterTypeCoercion(t reflect.Type) {
+	delete(typeCoercions, t)
+}
+
+// registeredCoercion converts value with the coercion registered for its
+// type, if any.
+func registeredCoercion(value interface{}) (interface{}, bool) {
+	coerce, ok := typeCoercions[reflect.TypeOf(value)]
+	if !ok {
+		return nil, false
+	}
+	return coerce(value), true
+}
+
 // As per the GraphQL Spec, Integers are only treated as valid when a valid
 // 32-bit signed integer, providing the broadest support across platforms.
 //
 // n.b. JavaScript's integers are safe between -(2^53 - 1) and 2^53 - 1 because
 // they are internally represented as IEEE 754 doubles.
 func coerceInt(value interface{}) interface{} {
+	i, err := coerceIntE(value, true)
+	if err != nil {
+		// If the value cannot be transformed into an int, return nil instead of '0'
+		// to denote 'no integer found'
+		return nil
+	}
+	return i
+}
+
+// CoerceIntE converts value to a 32-bit signed integer the way the Int
+// scalar does, except that floats with a fractional part are rejected
+// instead of truncated. The returned error describes why value could not
+// be converted.
+func CoerceIntE(value interface{}) (int, error) {
+	return coerceIntE(value, false)
+}
+
+func intRangeError(value interface{}) error {
+	return fmt.Errorf("Int cannot represent value %v: out of 32-bit signed integer range", value)
+}
+
+// coerceIntE implements CoerceIntE; when truncate is set, floats are
+// truncated towards zero instead of rejected when they have a fractional
+// part.
+func coerceIntE(value interface{}, truncate bool) (int, error) {
 	switch value := value.(type) {
 	case bool:
 		if value == true {
-			return 1
+			return 1, nil
 		}
-		return 0
+		return 0, nil
 	case int:
 		if value < int(math.MinInt32) || value > int(math.MaxInt32) {
-			return nil
+			return 0, intRangeError(value)
 		}
-		return value
+		return value, nil
 	case *int:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case int8:
-		return int(value)
+		return int(value), nil
 	case *int8:
-		return int(*value)
+		return int(*value), nil
 	case int16:
-		return int(value)
+		return int(value), nil
 	case *int16:
-		return int(*value)
+		return int(*value), nil
 	case int32:
-		return int(value)
+		return int(value), nil
 	case *int32:
-		return int(*value)
+		return int(*value), nil
 	case int64:
 		if value < int64(math.MinInt32) || value > int64(math.MaxInt32) {
-			return nil
+			return 0, intRangeError(value)
 		}
-		return int(value)
+		return int(value), nil
 	case *int64:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case uint:
 		if value > math.MaxInt32 {
-			return nil
+			return 0, intRangeError(value)
 		}
-		return int(value)
+		return int(value), nil
 	case *uint:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case uint8:
-		return int(value)
+		return int(value), nil
 	case *uint8:
-		return int(*value)
+		return int(*value), nil
 	case uint16:
-		return int(value)
+		return int(value), nil
 	case *uint16:
-		return int(*value)
+		return int(*value), nil
 	case uint32:
 		if value > uint32(math.MaxInt32) {
-			return nil
+			return 0, intRangeError(value)
 		}
-		return int(value)
+		return int(value), nil
 	case *uint32:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case uint64:
 		if value > uint64(math.MaxInt32) {
-			return nil
+			return 0, intRangeError(value)
 		}
-		return int(value)
+		return int(value), nil
 	case *uint64:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case float32:
-		if value < float32(math.MinInt32) || value > float32(math.MaxInt32) {
-			return nil
-		}
-		return int(value)
+		return coerceIntE(float64(value), truncate)
 	case *float32:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case float64:
-		if value < float64(math.MinInt32) || value > float64(math.MaxInt32) {
-			return nil
+		if math.IsNaN(value) || value < float64(math.MinInt32) || value > float64(math.MaxInt32) {
+			return 0, intRangeError(value)
 		}
-		return int(value)
+		if !truncate && value != math.Trunc(value) {
+			return 0, fmt.Errorf("Int cannot represent non-integer float: %v", value)
+		}
+		return int(value), nil
 	case *float64:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	case string:
 		val, err := strconv.ParseFloat(value, 0)
 		if err != nil {
-			return nil
+			return 0, fmt.Errorf("Int cannot represent non-numeric string: %q", value)
 		}
-		return coerceInt(val)
+		return coerceIntE(val, truncate)
 	case *string:
-		return coerceInt(*value)
+		return coerceIntE(*value, truncate)
 	}
-
-	// If the value cannot be transformed into an int, return nil instead of '0'
-	// to denote 'no integer found'
-	return nil
+	if coerced, ok := registeredCoercion(value); ok {
+		return coerceIntE(coerced, truncate)
+	}
+	if value, ok := value.(fmt.Stringer); ok {
+		// Custom numeric types are coerced through their string form.
+		return coerceIntE(value.String(), truncate)
+	}
+	return 0, fmt.Errorf("Int cannot represent value of type %T", value)
 }
 
 // Int is the GraphQL Integer type definition.
@@ -123,37 +241,96 @@ var Int = NewScalar(ScalarConfig{
 	},
 })
 
+// floatUnsupportedTypeError is the error CoerceFloatE returns for values of
+// a type it has no conversion for.
+type floatUnsupportedTypeError struct {
+	value interface{}
+}
+
+func (e floatUnsupportedTypeError) Error() string {
+	return fmt.Sprintf("Float cannot represent value of type %T", e.value)
+}
+
 func coerceFloat(value interface{}) interface{} {
+	f, err := CoerceFloatE(value)
+	if _, ok := err.(floatUnsupportedTypeError); ok {
+		// Values of unsupported types have always serialized as 0.
+		return 0.0
+	}
+	if err != nil {
+		return nil
+	}
+	// float32 values are passed through so they keep their own precision.
+	switch value := value.(type) {
+	case float32:
+		return value
+	case *float32:
+		return *value
+	}
+	return f
+}
+
+// CoerceFloatE converts value to a float64 the way the Float scalar does.
+// The returned error describes why value could not be converted.
+func CoerceFloatE(value interface{}) (float64, error) {
 	switch value := value.(type) {
 	case bool:
 		if value == true {
-			return 1.0
+			return 1.0, nil
 		}
-		return 0.0
+		return 0.0, nil
 	case *bool:
-		return coerceFloat(*value)
+		return CoerceFloatE(*value)
 	case int:
-		return float64(value)
+		return float64(value), nil
+	case *int:
+		return CoerceFloatE(*value)
+	case int8:
+		return float64(value), nil
+	case int16:
+		return float64(value), nil
+	case int32:
+		return float64(value), nil
 	case *int32:
-		return coerceFloat(*value)
+		return CoerceFloatE(*value)
+	case int64:
+		return float64(value), nil
+	case *int64:
+		return CoerceFloatE(*value)
+	case uint:
+		return float64(value), nil
+	case uint8:
+		return float64(value), nil
+	case uint16:
+		return float64(value), nil
+	case uint32:
+		return float64(value), nil
+	case uint64:
+		return float64(value), nil
 	case float32:
-		return value
+		return float64(value), nil
 	case *float32:
-		return coerceFloat(*value)
+		return CoerceFloatE(*value)
 	case float64:
-		return value
+		return value, nil
 	case *float64:
-		return coerceFloat(*value)
+		return CoerceFloatE(*value)
 	case string:
 		val, err := strconv.ParseFloat(value, 0)
 		if err != nil {
-			return nil
+			return 0, fmt.Errorf("Float cannot represent non-numeric string: %q", value)
 		}
-		return val
+		return val, nil
 	case *string:
-		return coerceFloat(*value)
+		return CoerceFloatE(*value)
 	}
-	return 0.0
+	if coerced, ok := registeredCoercion(value); ok {
+		return CoerceFloatE(coerced)
+	}
+	if value, ok := value.(fmt.Stringer); ok {
+		return CoerceFloatE(value.String())
+	}
+	return 0, floatUnsupportedTypeError{value}
 }
 
 // Float is the GraphQL float type definition.
@@ -180,10 +357,30 @@ var Float = NewScalar(ScalarConfig{
 })
 
 func coerceString(value interface{}) interface{} {
-	if v, ok := value.(*string); ok {
-		return *v
+	str, err := CoerceStringE(value)
+	if err != nil {
+		// Values CoerceStringE rejects, such as nil, are formatted as is.
+		return fmt.Sprintf("%v", value)
+	}
+	return str
+}
+
+// CoerceStringE converts value to a string the way the String scalar does.
+// Any non-nil value can be represented as a string.
+func CoerceStringE(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "", errors.New("String cannot represent a nil value")
+	case *string:
+		if value == nil {
+			return "", errors.New("String cannot represent a nil value")
+		}
+		return *value, nil
 	}
-	return fmt.Sprintf("%v", value)
+	if coerced, ok := registeredCoercion(value); ok {
+		return CoerceStringE(coerced)
+	}
+	return fmt.Sprintf("%v", value), nil
 }
 
 // String is the GraphQL string type definition
@@ -204,42 +401,44 @@ var String = NewScalar(ScalarConfig{
 })
 
 func coerceBool(value interface{}) interface{} {
+	// Values that cannot be converted are treated as false.
+	b, _ := CoerceBoolE(value)
+	return b
+}
+
+// CoerceBoolE converts value to a bool the way the Boolean scalar does. The
+// returned error reports values of a type that has no boolean meaning.
+func CoerceBoolE(value interface{}) (bool, error) {
 	switch value := value.(type) {
 	case bool:
-		return value
+		return value, nil
 	case *bool:
-		return *value
+		return *value, nil
 	case string:
 		switch value {
 		case "", "false":
-			return false
+			return false, nil
 		}
-		return true
+		return true, nil
 	case *string:
-		return coerceBool(*value)
+		return CoerceBoolE(*value)
 	case float64:
-		if value != 0 {
-			return true
-		}
-		return false
+		return value != 0, nil
 	case *float64:
-		return coerceBool(*value)
+		return CoerceBoolE(*value)
 	case float32:
-		if value != 0 {
-			return true
-		}
-		return false
+		return value != 0, nil
 	case *float32:
-		return coerceBool(*value)
+		return CoerceBoolE(*value)
 	case int:
-		if value != 0 {
-			return true
-		}
-		return false
+		return value != 0, nil
 	case *int:
-		return coerceBool(*value)
+		return CoerceBoolE(*value)
 	}
-	return false
+	if coerced, ok := registeredCoercion(value); ok {
+		return CoerceBoolE(coerced)
+	}
+	return false, fmt.Errorf("Boolean cannot represent value of type %T", value)
 }
 
 // Boolean is the GraphQL boolean type definition
@@ -249,14 +448,41 @@ var Boolean = NewScalar(ScalarConfig{
 	Serialize:   coerceBool,
 	ParseValue:  coerceBool,
 	ParseLiteral: func(valueAST ast.Value) interface{} {
+		// Int and String literals are coerced like variable values are, so
+		// that `1` and `"true"` mean the same in both.
 		switch valueAST := valueAST.(type) {
 		case *ast.BooleanValue:
 			return valueAST.Value
+		case *ast.IntValue:
+			if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
+				return coerceBool(intValue)
+			}
+		case *ast.StringValue:
+			return coerceBool(valueAST.Value)
 		}
 		return nil
 	},
 })
 
+// coerceID converts value to an ID string. Integers, including integral
+// floats and json.Number values decoded from variables, are formatted in
+// full, so that they produce the same string as the equivalent literal.
+func coerceID(value interface{}) interface{} {
+	switch value := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", value)
+	case json.Number:
+		return value.String()
+	case float64:
+		if value == math.Trunc(value) && !math.IsInf(value, 0) {
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	case *float64:
+		return coerceID(*value)
+	}
+	return coerceString(value)
+}
+
 // ID is the GraphQL id type definition
 var ID = NewScalar(ScalarConfig{
 	Name: "ID",
@@ -265,8 +491,49 @@ var ID = NewScalar(ScalarConfig{
 		"response as a String; however, it is not intended to be human-readable. " +
 		"When expected as an input type, any string (such as `\"4\"`) or integer " +
 		"(such as `4`) input value will be accepted as an ID.",
-	Serialize:  coerceString,
-	ParseValue: coerceString,
+	Serialize:  coerceID,
+	ParseValue: coerceID,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.IntValue:
+			return valueAST.Value
+		case *ast.StringValue:
+			return valueAST.Value
+		}
+		return nil
+	},
+})
+
+// coerceStrictID converts strings, integers and fmt.Stringer values to an ID,
+// rather than formatting any value with %v as coerceString does.
+func coerceStrictID(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		return value
+	case *string:
+		if value == nil {
+			return nil
+		}
+		return *value
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", value)
+	case fmt.Stringer:
+		return value.String()
+	}
+	return nil
+}
+
+// StrictID is a variant of the GraphQL id type definition that rejects
+// values other than strings, integers and fmt.Stringer values, which ID
+// would turn into meaningless identifiers. It has a name of its own, so that
+// it can be used alongside ID in a schema.
+var StrictID = NewScalar(ScalarConfig{
+	Name: "StrictID",
+	Description: "The `StrictID` scalar type represents a unique identifier like `ID`, " +
+		"but only strings, integers and values with a `String` method are " +
+		"accepted as one.",
+	Serialize:  coerceStrictID,
+	ParseValue: coerceStrictID,
 	ParseLiteral: func(valueAST ast.Value) interface{} {
 		switch valueAST := valueAST.(type) {
 		case *ast.IntValue:
@@ -327,3 +594,173 @@ var DateTime = NewScalar(ScalarConfig{
 		return nil
 	},
 })
+
+func serializeDateTimeSeconds(value interface{}) interface{} {
+	switch value := value.(type) {
+	case time.Time:
+		return value.Truncate(time.Second).Format(time.RFC3339)
+	case *time.Time:
+		return serializeDateTimeSeconds(*value)
+	default:
+		return nil
+	}
+}
+
+func unserializeDateTimeSeconds(value interface{}) interface{} {
+	if t, ok := unserializeDateTime(value).(time.Time); ok {
+		return t.Truncate(time.Second)
+	}
+	return nil
+}
+
+// DateTimeSeconds is like DateTime, but truncated to whole seconds.
+var DateTimeSeconds = NewScalar(ScalarConfig{
+	Name: "DateTimeSeconds",
+	Description: "The `DateTimeSeconds` scalar type represents a DateTime with second" +
+		" precision. The DateTimeSeconds is serialized as an RFC 3339 quoted string" +
+		" without fractional seconds",
+	Serialize:  serializeDateTimeSeconds,
+	ParseValue: unserializeDateTimeSeconds,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeDateTimeSeconds(valueAST.Value)
+		}
+		return nil
+	},
+})
+
+// dateTimeLocalLayout is the layout of RFC 3339 date-times without a UTC
+// offset. Fractional seconds are accepted when parsing.
+const dateTimeLocalLayout = "2006-01-02T15:04:05"
+
+// NewDateTimeScalarLoc creates a DateTime scalar that, in addition to RFC 3339
+// date-times, accepts date-times without a UTC offset and interprets them in
+// loc. The scalar is named after loc, such as `DateTimeAmericaNewYork`.
+func NewDateTimeScalarLoc(loc *time.Location) *Scalar {
+	if loc == nil {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError("DateTime must be given a location."),
+		}
+	}
+	name := "DateTime" + typeNameFragment(loc.String())
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				return t
+			}
+			if t, err := time.ParseInLocation(dateTimeLocalLayout, value, loc); err == nil {
+				return t
+			}
+		case *string:
+			return parse(*value)
+		case []byte:
+			return parse(string(value))
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents a DateTime." +
+			" The DateTime is serialized as an RFC 3339 quoted string." +
+			" Date-times without a UTC offset are interpreted in " + loc.String() + ".",
+		Serialize:  serializeDateTime,
+		ParseValue: parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
+
+// NewMultiLayoutDateTimeScalar creates a DateTime scalar that parses
+// date-times with each of the time.Parse layouts in turn, returning the
+// first successful parse, and serializes them with the first layout. The
+// scalar is named after the layouts, so that `[]string{"2006-01-02",
+// "02/01/2006"}` yields `DateTime20060102Or02012006`.
+func NewMultiLayoutDateTimeScalar(layouts []string) *Scalar {
+	if len(layouts) == 0 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError("DateTime must be given at least one layout."),
+		}
+	}
+	name := "DateTime" + typeNameFragment(strings.Join(layouts, " Or "))
+	var serialize func(value interface{}) interface{}
+	serialize = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case time.Time:
+			return value.Format(layouts[0])
+		case *time.Time:
+			return serialize(*value)
+		}
+		return nil
+	}
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			for _, layout := range layouts {
+				if t, err := time.Parse(layout, value); err == nil {
+					return t
+				}
+			}
+		case *string:
+			return parse(*value)
+		case []byte:
+			return parse(string(value))
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents a DateTime." +
+			" The DateTime is serialized as a string of layout " + layouts[0] + ".",
+		Serialize:  serialize,
+		ParseValue: parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
+
+// CoerceObject applies the coercer of each field in fields to the matching
+// entry of value, which must be a `map[string]interface{}`, and returns the
+// coerced map. Fields that are absent or null in value are omitted. The
+// returned error names the first field, in alphabetical order, whose
+// coercer returned nil.
+func CoerceObject(fields map[string]func(interface{}) interface{}, value interface{}) (map[string]interface{}, error) {
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected an object, found %T", value)
+	}
+
+	// to ensure a stable order of field evaluation
+	fieldNames := []string{}
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	obj := map[string]interface{}{}
+	for _, fieldName := range fieldNames {
+		fieldValue, ok := valueMap[fieldName]
+		if !ok || fieldValue == nil {
+			continue
+		}
+		coerced := fields[fieldName](fieldValue)
+		if coerced == nil {
+			return nil, fmt.Errorf(`In field "%v": invalid value %v`, fieldName, fieldValue)
+		}
+		obj[fieldName] = coerced
+	}
+	return obj, nil
+}
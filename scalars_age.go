@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceAge(value interface{}) interface{} {
+	age, ok := coerceInt(value).(int)
+	if !ok || age < 0 || age > 150 {
+		return nil
+	}
+	return age
+}
+
+// Age is the GraphQL age in years type definition.
+var Age = NewScalar(ScalarConfig{
+	Name:        "Age",
+	Description: "The `Age` scalar type represents an age in whole years, from 0 to 150.",
+	Serialize:   coerceAge,
+	ParseValue:  coerceAge,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.IntValue:
+			if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
+				return coerceAge(intValue)
+			}
+		}
+		return nil
+	},
+})
@@ -0,0 +1,25 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Age_AcceptsAgesInRange(t *testing.T) {
+	if result := graphql.Age.ParseLiteral(&ast.IntValue{Value: "42"}); result != 42 {
+		t.Fatalf("Expected 42, got: %v", result)
+	}
+	if result := graphql.Age.ParseValue(0); result != 0 {
+		t.Fatalf("Expected 0, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Age_RejectsAgesOutOfRange(t *testing.T) {
+	for _, value := range []string{"-1", "200"} {
+		if result := graphql.Age.ParseLiteral(&ast.IntValue{Value: value}); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// ARNComponents holds the segments of an Amazon Resource Name.
+type ARNComponents struct {
+	Partition string
+	Service   string
+	Region    string
+	AccountID string
+	Resource  string
+}
+
+// ParseARN splits an Amazon Resource Name of the form
+// `arn:partition:service:region:account:resource` into its components. The
+// region and account may be empty; the resource may itself contain colons.
+func ParseARN(arn string) (ARNComponents, bool) {
+	segments := strings.SplitN(arn, ":", 6)
+	if len(segments) != 6 || segments[0] != "arn" {
+		return ARNComponents{}, false
+	}
+	components := ARNComponents{
+		Partition: segments[1],
+		Service:   segments[2],
+		Region:    segments[3],
+		AccountID: segments[4],
+		Resource:  segments[5],
+	}
+	if components.Partition == "" || components.Service == "" || components.Resource == "" {
+		return ARNComponents{}, false
+	}
+	return components, true
+}
+
+func coerceARN(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if _, ok := ParseARN(value); !ok {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceARN(*value)
+	}
+	return nil
+}
+
+// ARN is the GraphQL Amazon Resource Name type definition.
+var ARN = NewScalar(ScalarConfig{
+	Name: "ARN",
+	Description: "The `ARN` scalar type represents an Amazon Resource Name of the form " +
+		"`arn:partition:service:region:account:resource`.",
+	Serialize:  coerceARN,
+	ParseValue: coerceARN,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceARN(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,41 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_ARN_AcceptsValidARN(t *testing.T) {
+	arn := "arn:aws:s3:::my-bucket/path/to/object"
+	if result := graphql.ARN.ParseValue(arn); result != arn {
+		t.Fatalf("Expected %v, got: %v", arn, result)
+	}
+	lambda := "arn:aws:lambda:us-east-1:123456789012:function:my-function"
+	if result := graphql.ARN.ParseLiteral(&ast.StringValue{Value: lambda}); result != lambda {
+		t.Fatalf("Expected %v, got: %v", lambda, result)
+	}
+}
+
+func TestTypeSystem_Scalar_ARN_RejectsMalformedARN(t *testing.T) {
+	for _, value := range []string{"arn:aws:s3::my-bucket", "urn:aws:s3:::bucket", "arn::s3:::bucket"} {
+		if result := graphql.ARN.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestParseARN_ExposesComponents(t *testing.T) {
+	expected := graphql.ARNComponents{
+		Partition: "aws",
+		Service:   "lambda",
+		Region:    "us-east-1",
+		AccountID: "123456789012",
+		Resource:  "function:my-function",
+	}
+	result, ok := graphql.ParseARN("arn:aws:lambda:us-east-1:123456789012:function:my-function")
+	if !ok || result != expected {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+}
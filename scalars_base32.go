@@ -0,0 +1,81 @@
+package graphql
+
+import (
+	"encoding/base32"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewBase32Scalar creates a `Base32` scalar for binary data in the standard
+// base32 encoding of RFC 4648. Parsed values are []byte. When padded is
+// set, encoded values carry `=` padding, which is then required on input;
+// otherwise the scalar is named `UnpaddedBase32`.
+func NewBase32Scalar(padded bool) *Scalar {
+	name := "Base32"
+	encode := base32.StdEncoding.EncodeToString
+	decode := base32.StdEncoding.DecodeString
+	description := "The `Base32` scalar type represents binary data encoded in " +
+		"padded base32."
+	if !padded {
+		name = "UnpaddedBase32"
+		// base32.Encoding.WithPadding is not available before Go 1.9, so the
+		// padding is trimmed and restored by hand.
+		encode = func(b []byte) string {
+			return strings.TrimRight(base32.StdEncoding.EncodeToString(b), "=")
+		}
+		decode = func(s string) ([]byte, error) {
+			if strings.Contains(s, "=") {
+				return nil, base32.CorruptInputError(strings.Index(s, "="))
+			}
+			if rem := len(s) % 8; rem > 0 {
+				s += strings.Repeat("=", 8-rem)
+			}
+			return base32.StdEncoding.DecodeString(s)
+		}
+		description = "The `UnpaddedBase32` scalar type represents binary data " +
+			"encoded in base32 without padding."
+	}
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			b, err := decode(value)
+			if err != nil {
+				return nil
+			}
+			return b
+		case *string:
+			return parse(*value)
+		}
+		return nil
+	}
+	serialize := func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case []byte:
+			return encode(value)
+		case string:
+			if parse(value) == nil {
+				return nil
+			}
+			return value
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name:        name,
+		Description: description,
+		Serialize:   serialize,
+		ParseValue:  parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
+
+// Base32 is the GraphQL padded base32 binary data type definition.
+var Base32 = NewBase32Scalar(true)
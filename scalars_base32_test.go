@@ -0,0 +1,56 @@
+package graphql_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Base32_RoundTripsBytes(t *testing.T) {
+	data := []byte("hello")
+	if result := graphql.Base32.Serialize(data); result != "NBSWY3DP" {
+		t.Fatalf("Expected NBSWY3DP, got: %v", result)
+	}
+	parsed, ok := graphql.Base32.ParseLiteral(&ast.StringValue{Value: "NBSWY3DP"}).([]byte)
+	if !ok || !bytes.Equal(parsed, data) {
+		t.Fatalf("Expected %v, got: %v", data, parsed)
+	}
+	unpadded := graphql.NewBase32Scalar(false)
+	if result := unpadded.Serialize([]byte("hi")); result != "NBUQ" {
+		t.Fatalf("Expected NBUQ, got: %v", result)
+	}
+	if result := graphql.Base32.Serialize([]byte("hi")); result != "NBUQ====" {
+		t.Fatalf("Expected NBUQ====, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Base32_RejectsInvalidCharacters(t *testing.T) {
+	for _, value := range []string{"NBSWY3D1", "NBUQ"} {
+		if result := graphql.Base32.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Base32_NamesUnpaddedVariant(t *testing.T) {
+	unpadded := graphql.NewBase32Scalar(false)
+	if unpadded.Name() != "UnpaddedBase32" {
+		t.Fatalf("Expected UnpaddedBase32, got: %v", unpadded.Name())
+	}
+	assertScalarsCoexist(t, graphql.Base32, unpadded)
+}
+
+func TestTypeSystem_Scalar_Base32_UnpaddedRejectsPadding(t *testing.T) {
+	unpadded := graphql.NewBase32Scalar(false)
+	parsed, ok := unpadded.ParseValue("NBUQ").([]byte)
+	if !ok || string(parsed) != "hi" {
+		t.Fatalf("Expected hi, got: %v", parsed)
+	}
+	for _, value := range []string{"NBUQ====", "NBU", "NBUQ=="} {
+		if result := unpadded.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// bearerTokenRegexp matches the b64token syntax of RFC 6750.
+var bearerTokenRegexp = regexp.MustCompile(`^[A-Za-z0-9\-._~+/]+=*$`)
+
+func coerceBearerToken(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		const prefix = "bearer "
+		if len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+			value = value[len(prefix):]
+		}
+		if !bearerTokenRegexp.MatchString(value) {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceBearerToken(*value)
+	}
+	return nil
+}
+
+// BearerToken is the GraphQL OAuth bearer token type definition.
+var BearerToken = NewScalar(ScalarConfig{
+	Name: "BearerToken",
+	Description: "The `BearerToken` scalar type represents an OAuth 2.0 bearer token. " +
+		"Input may carry a `Bearer ` prefix, which is stripped.",
+	Serialize:  coerceBearerToken,
+	ParseValue: coerceBearerToken,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceBearerToken(valueAST.Value)
+		}
+		return nil
+	},
+	RedactInErrors: true,
+})
@@ -0,0 +1,28 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_BearerToken_StripsPrefix(t *testing.T) {
+	for _, value := range []string{"Bearer abc-123_x.y", "bearer abc-123_x.y", "abc-123_x.y"} {
+		if result := graphql.BearerToken.ParseLiteral(&ast.StringValue{Value: value}); result != "abc-123_x.y" {
+			t.Fatalf("Expected abc-123_x.y for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_BearerToken_RejectsInvalidTokens(t *testing.T) {
+	for _, value := range []string{"", "Bearer ", "abc def"} {
+		if result := graphql.BearerToken.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_BearerToken_RedactsTokenInErrors(t *testing.T) {
+	assertRedactedInErrors(t, graphql.BearerToken, "secret token")
+}
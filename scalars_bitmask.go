@@ -0,0 +1,36 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewBitmaskScalar creates an integer scalar for bitmasks, such as a set of
+// permission flags, that rejects values with bits outside validBits set.
+func NewBitmaskScalar(name string, validBits int) *Scalar {
+	coerce := func(value interface{}) interface{} {
+		mask, ok := coerceInt(value).(int)
+		if !ok || mask < 0 || mask&^validBits != 0 {
+			return nil
+		}
+		return mask
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents a bitmask of the "+
+			"flags %#b.", name, validBits),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.IntValue:
+				if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
+					return coerce(intValue)
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Bitmask_AcceptsValidBits(t *testing.T) {
+	permissions := graphql.NewBitmaskScalar("Permissions", 0x7)
+	if result := permissions.ParseLiteral(&ast.IntValue{Value: "5"}); result != 5 {
+		t.Fatalf("Expected 5, got: %v", result)
+	}
+	if result := permissions.ParseValue(0); result != 0 {
+		t.Fatalf("Expected 0, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Bitmask_RejectsUnknownBits(t *testing.T) {
+	permissions := graphql.NewBitmaskScalar("Permissions", 0x7)
+	for _, value := range []string{"13", "-1"} {
+		if result := permissions.ParseLiteral(&ast.IntValue{Value: value}); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+}
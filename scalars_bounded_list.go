@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// coerceListItems applies fn to each element of the slice or array value,
+// failing when value is not a list or fn rejects any element.
+func coerceListItems(value interface{}, fn func(interface{}) interface{}) ([]interface{}, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+	result := make([]interface{}, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := fn(v.Index(i).Interface())
+		if isNullish(item) {
+			return nil, false
+		}
+		result[i] = item
+	}
+	return result, true
+}
+
+// parseListLiteralItems parses each element of a list literal with s,
+// failing when valueAST is not a list or s rejects any element.
+func parseListLiteralItems(valueAST ast.Value, s *Scalar) ([]interface{}, bool) {
+	listAST, ok := valueAST.(*ast.ListValue)
+	if !ok {
+		return nil, false
+	}
+	result := make([]interface{}, len(listAST.Values))
+	for i, itemAST := range listAST.Values {
+		item := s.ParseLiteral(itemAST)
+		if isNullish(item) {
+			return nil, false
+		}
+		result[i] = item
+	}
+	return result, true
+}
+
+// newListScalar creates a scalar for lists of values of s that satisfy
+// valid.
+func newListScalar(s *Scalar, name, description string, valid func([]interface{}) bool) *Scalar {
+	coerceEach := func(fn func(interface{}) interface{}) func(interface{}) interface{} {
+		return func(value interface{}) interface{} {
+			items, ok := coerceListItems(value, fn)
+			if !ok || !valid(items) {
+				return nil
+			}
+			return items
+		}
+	}
+	return NewScalar(ScalarConfig{
+		Name:        name,
+		Description: description,
+		Serialize:   coerceEach(s.Serialize),
+		ParseValue:  coerceEach(s.ParseValue),
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			items, ok := parseListLiteralItems(valueAST, s)
+			if !ok || !valid(items) {
+				return nil
+			}
+			return items
+		},
+		RedactInErrors: s.scalarConfig.RedactInErrors,
+	})
+}
+
+// BoundedList creates a scalar for lists of between min and max values of s,
+// inclusive. A list is rejected when its length is out of bounds or when s
+// rejects any of its elements. The scalar is named after s and its bounds,
+// such as `StringList1To5`, so that lists with different bounds can be used
+// in the same schema.
+func BoundedList(s *Scalar, min, max int) *Scalar {
+	if min < 0 || max < min {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`%vList bounds [%v, %v] are invalid.`, s.Name(), min, max)),
+		}
+	}
+	name := fmt.Sprintf("%vList%vTo%v", s.Name(), min, max)
+	return newListScalar(s, name,
+		fmt.Sprintf("The `%v` scalar type represents a list of %v to %v `%v` values.",
+			name, min, max, s.Name()),
+		func(items []interface{}) bool {
+			return len(items) >= min && len(items) <= max
+		},
+	)
+}
@@ -0,0 +1,71 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func stringListValue(values ...string) *ast.ListValue {
+	list := &ast.ListValue{}
+	for _, value := range values {
+		list.Values = append(list.Values, &ast.StringValue{Value: value})
+	}
+	return list
+}
+
+func TestTypeSystem_Scalar_BoundedList_AcceptsListWithinBounds(t *testing.T) {
+	tags := graphql.BoundedList(graphql.String, 1, 5)
+	expected := []interface{}{"a", "b"}
+	if result := tags.ParseLiteral(stringListValue("a", "b")); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := tags.ParseValue([]string{"a", "b"}); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_BoundedList_RejectsListOutOfBounds(t *testing.T) {
+	tags := graphql.BoundedList(graphql.String, 1, 5)
+	if result := tags.ParseLiteral(stringListValue("a", "b", "c", "d", "e", "f")); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := tags.ParseLiteral(stringListValue()); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_BoundedList_RejectsInvalidElement(t *testing.T) {
+	ints := graphql.BoundedList(graphql.Int, 1, 5)
+	list := &ast.ListValue{Values: []ast.Value{
+		&ast.IntValue{Value: "1"},
+		&ast.StringValue{Value: "two"},
+	}}
+	if result := ints.ParseLiteral(list); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_BoundedList_NamesIncludeBounds(t *testing.T) {
+	fewTags := graphql.BoundedList(graphql.String, 1, 5)
+	manyTags := graphql.BoundedList(graphql.String, 0, 10)
+	uniqueTags := graphql.UniqueList(graphql.String)
+	if fewTags.Name() != "StringList1To5" {
+		t.Fatalf("Expected StringList1To5, got: %v", fewTags.Name())
+	}
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"fewTags":    &graphql.Field{Type: fewTags},
+				"manyTags":   &graphql.Field{Type: manyTags},
+				"uniqueTags": &graphql.Field{Type: uniqueTags},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Expected differently configured lists to coexist, got: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// charsets lists the known charset names in canonical form.
+var charsets = []string{
+	"utf-8", "utf-16", "utf-16be", "utf-16le", "utf-32", "us-ascii",
+	"iso-8859-1", "iso-8859-2", "iso-8859-5", "iso-8859-7", "iso-8859-9", "iso-8859-15",
+	"windows-1250", "windows-1251", "windows-1252", "windows-1253", "windows-1254",
+	"shift_jis", "euc-jp", "iso-2022-jp", "euc-kr", "gb2312", "gbk", "gb18030",
+	"big5", "koi8-r", "koi8-u",
+}
+
+// charsetAliases maps common aliases, keyed like charsetKey, to canonical
+// charset names.
+var charsetAliases = map[string]string{
+	"ascii":  "us-ascii",
+	"latin1": "iso-8859-1",
+	"latin2": "iso-8859-2",
+	"cp1250": "windows-1250",
+	"cp1251": "windows-1251",
+	"cp1252": "windows-1252",
+	"sjis":   "shift_jis",
+}
+
+// charsetKey reduces a charset name to lowercase letters and digits, so that
+// spellings such as `UTF8`, `utf_8` and `UTF-8` compare equal.
+func charsetKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+func init() {
+	for _, charset := range charsets {
+		charsetAliases[charsetKey(charset)] = charset
+	}
+}
+
+func coerceCharset(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		charset, ok := charsetAliases[charsetKey(strings.TrimSpace(value))]
+		if !ok {
+			return nil
+		}
+		return charset
+	case *string:
+		return coerceCharset(*value)
+	}
+	return nil
+}
+
+// Charset is the GraphQL MIME charset type definition.
+var Charset = NewScalar(ScalarConfig{
+	Name: "Charset",
+	Description: "The `Charset` scalar type represents a known MIME charset name, " +
+		"such as `utf-8`, normalized to its canonical lowercase form.",
+	Serialize:  coerceCharset,
+	ParseValue: coerceCharset,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceCharset(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,28 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Charset_NormalizesAliases(t *testing.T) {
+	for value, expected := range map[string]string{
+		"UTF8":      "utf-8",
+		"utf-8":     "utf-8",
+		"ISO8859_1": "iso-8859-1",
+		"latin1":    "iso-8859-1",
+		"Shift-JIS": "shift_jis",
+	} {
+		if result := graphql.Charset.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Charset_RejectsUnknownCharsets(t *testing.T) {
+	if result := graphql.Charset.ParseValue("unknown-charset"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var colorHexRegexp = regexp.MustCompile(`^#([0-9a-f]{3}|[0-9a-f]{6})$`)
+
+// colorNames maps the CSS basic color keywords and some common extended
+// ones to their hex values.
+var colorNames = map[string]string{
+	"aqua": "#00ffff", "black": "#000000", "blue": "#0000ff", "fuchsia": "#ff00ff",
+	"gray": "#808080", "green": "#008000", "lime": "#00ff00", "maroon": "#800000",
+	"navy": "#000080", "olive": "#808000", "purple": "#800080", "red": "#ff0000",
+	"silver": "#c0c0c0", "teal": "#008080", "white": "#ffffff", "yellow": "#ffff00",
+
+	"beige": "#f5f5dc", "brown": "#a52a2a", "chocolate": "#d2691e", "coral": "#ff7f50",
+	"crimson": "#dc143c", "cyan": "#00ffff", "darkgray": "#a9a9a9", "gold": "#ffd700",
+	"grey": "#808080", "indigo": "#4b0082", "khaki": "#f0e68c", "lavender": "#e6e6fa",
+	"lightgray": "#d3d3d3", "magenta": "#ff00ff", "orange": "#ffa500", "orchid": "#da70d6",
+	"pink": "#ffc0cb", "plum": "#dda0dd", "rebeccapurple": "#663399", "salmon": "#fa8072",
+	"skyblue": "#87ceeb", "tan": "#d2b48c", "tomato": "#ff6347", "turquoise": "#40e0d0",
+	"violet": "#ee82ee",
+}
+
+func coerceColor(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		color := strings.ToLower(strings.TrimSpace(value))
+		if hex, ok := colorNames[color]; ok {
+			return hex
+		}
+		if !colorHexRegexp.MatchString(color) {
+			return nil
+		}
+		if len(color) == 4 {
+			// Expand the `#rgb` shorthand to `#rrggbb`.
+			color = string([]byte{'#', color[1], color[1], color[2], color[2], color[3], color[3]})
+		}
+		return color
+	case *string:
+		return coerceColor(*value)
+	}
+	return nil
+}
+
+// Color is the GraphQL hex or named color type definition.
+var Color = NewScalar(ScalarConfig{
+	Name: "Color",
+	Description: "The `Color` scalar type represents a color given as a CSS color " +
+		"name or hex value, normalized to the `#rrggbb` form.",
+	Serialize:  coerceColor,
+	ParseValue: coerceColor,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceColor(valueAST.Value)
+		}
+		return nil
+	},
+})
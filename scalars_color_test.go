@@ -0,0 +1,29 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Color_NormalizesToHex(t *testing.T) {
+	for value, expected := range map[string]string{
+		"red":     "#ff0000",
+		"Navy":    "#000080",
+		"#FF0000": "#ff0000",
+		"#f80":    "#ff8800",
+	} {
+		if result := graphql.Color.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Color_RejectsUnknownColors(t *testing.T) {
+	for _, value := range []string{"notacolor", "#ff00", "#gg0000", "ff0000"} {
+		if result := graphql.Color.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
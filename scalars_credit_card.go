@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// luhnValid reports whether digits (a string of ASCII digits) passes the Luhn
+// checksum.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// coerceCreditCard strips spaces and dashes from value and returns the bare
+// digits if they form a 13 to 19 digit, Luhn-valid card number. The value is
+// never included in any output other than the return value.
+func coerceCreditCard(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		digits := strings.Map(func(r rune) rune {
+			if r == ' ' || r == '-' {
+				return -1
+			}
+			return r
+		}, value)
+		if len(digits) < 13 || len(digits) > 19 {
+			return nil
+		}
+		for _, r := range digits {
+			if r < '0' || r > '9' {
+				return nil
+			}
+		}
+		if !luhnValid(digits) {
+			return nil
+		}
+		return digits
+	case *string:
+		return coerceCreditCard(*value)
+	}
+	return nil
+}
+
+// CreditCard is the GraphQL credit card number type definition.
+var CreditCard = NewScalar(ScalarConfig{
+	Name: "CreditCard",
+	Description: "The `CreditCard` scalar type represents a payment card number of " +
+		"13 to 19 digits that passes the Luhn checksum. Spaces and dashes are " +
+		"stripped from the input.",
+	Serialize:  coerceCreditCard,
+	ParseValue: coerceCreditCard,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceCreditCard(valueAST.Value)
+		}
+		return nil
+	},
+	RedactInErrors: true,
+})
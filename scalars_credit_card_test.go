@@ -0,0 +1,77 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_CreditCard_AcceptsLuhnValidNumber(t *testing.T) {
+	expected := "4012888888881881"
+	if result := graphql.CreditCard.ParseValue("4012 8888-8888 1881"); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := graphql.CreditCard.ParseLiteral(&ast.StringValue{Value: "4012888888881881"}); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_CreditCard_RejectsTransposedDigits(t *testing.T) {
+	if result := graphql.CreditCard.ParseValue("4012888888818881"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_CreditCard_RejectsInvalidLength(t *testing.T) {
+	if result := graphql.CreditCard.ParseValue("4242"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+// assertRedactedInErrors checks that value, which scalar rejects, appears in
+// none of the errors reported for it as an argument literal or a variable.
+func assertRedactedInErrors(t *testing.T, scalar *graphql.Scalar, value string) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"check": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						"value": &graphql.ArgumentConfig{Type: scalar},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return true, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	for _, params := range []graphql.Params{
+		{Schema: schema, RequestString: `{ check(value: "` + value + `") }`},
+		{
+			Schema:         schema,
+			RequestString:  `query ($v: ` + scalar.Name() + `) { check(value: $v) }`,
+			VariableValues: map[string]interface{}{"v": value},
+		},
+	} {
+		result := graphql.Do(params)
+		if len(result.Errors) == 0 {
+			t.Fatalf("Expected %q to be rejected", value)
+		}
+		for _, err := range result.Errors {
+			if strings.Contains(err.Message, value) {
+				t.Fatalf("Expected %q to be redacted, got: %v", value, err.Message)
+			}
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_CreditCard_RedactsNumberInErrors(t *testing.T) {
+	assertRedactedInErrors(t, graphql.CreditCard, "4111111111111112")
+}
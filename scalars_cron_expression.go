@@ -0,0 +1,118 @@
+package graphql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronWeekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// cronFields lists the fields of a six-field cron expression; five-field
+// expressions omit the leading seconds field.
+var cronFields = []cronField{
+	{0, 59, nil},             // second
+	{0, 59, nil},             // minute
+	{0, 23, nil},             // hour
+	{1, 31, nil},             // day of month
+	{1, 12, cronMonthNames},  // month
+	{0, 7, cronWeekdayNames}, // day of week, 0 and 7 are both Sunday
+}
+
+func (f cronField) value(s string) (int, bool) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, false
+	}
+	return n, true
+}
+
+// valid reports whether s is a comma-separated list of `*`, single values or
+// `a-b` ranges, each optionally followed by a `/step`.
+func (f cronField) valid(s string) bool {
+	for _, item := range strings.Split(s, ",") {
+		rangePart := item
+		if slash := strings.Index(item, "/"); slash >= 0 {
+			rangePart = item[:slash]
+			step, err := strconv.Atoi(item[slash+1:])
+			if err != nil || step <= 0 {
+				return false
+			}
+		}
+		if rangePart == "*" {
+			continue
+		}
+		bounds := strings.Split(rangePart, "-")
+		if len(bounds) > 2 {
+			return false
+		}
+		low, ok := f.value(bounds[0])
+		if !ok {
+			return false
+		}
+		if len(bounds) == 2 {
+			high, ok := f.value(bounds[1])
+			if !ok || high < low {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func coerceCronExpression(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		parts := strings.Fields(value)
+		fields := cronFields
+		switch len(parts) {
+		case 5:
+			fields = cronFields[1:]
+		case 6:
+		default:
+			return nil
+		}
+		for i, part := range parts {
+			if !fields[i].valid(part) {
+				return nil
+			}
+		}
+		return value
+	case *string:
+		return coerceCronExpression(*value)
+	}
+	return nil
+}
+
+// CronExpression is the GraphQL cron schedule type definition.
+var CronExpression = NewScalar(ScalarConfig{
+	Name: "CronExpression",
+	Description: "The `CronExpression` scalar type represents a cron schedule of five " +
+		"fields (minute, hour, day of month, month, day of week) or six fields " +
+		"with a leading seconds field.",
+	Serialize:  coerceCronExpression,
+	ParseValue: coerceCronExpression,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceCronExpression(valueAST.Value)
+		}
+		return nil
+	},
+})
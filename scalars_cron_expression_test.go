@@ -0,0 +1,38 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_CronExpression_AcceptsValidExpressions(t *testing.T) {
+	for _, value := range []string{
+		"*/5 * * * *",
+		"0 9-17 * * MON-FRI",
+		"30 0 1,15 */2 0",
+		"0 */10 * * * *",
+	} {
+		if result := graphql.CronExpression.ParseValue(value); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.CronExpression.ParseLiteral(&ast.StringValue{Value: "0 0 * * *"}); result != "0 0 * * *" {
+		t.Fatalf("Expected 0 0 * * *, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_CronExpression_RejectsInvalidExpressions(t *testing.T) {
+	for _, value := range []string{
+		"99 * * * *",
+		"* * * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * 0 * *",
+	} {
+		if result := graphql.CronExpression.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
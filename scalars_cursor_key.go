@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceCursorKey(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		// Encoded surrogate halves are not valid UTF-8, so ValidString also
+		// rejects lone surrogates.
+		if value == "" || !utf8.ValidString(value) || strings.ContainsRune(value, 0) {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceCursorKey(*value)
+	}
+	return nil
+}
+
+// CursorKey is the GraphQL paging cursor type definition.
+var CursorKey = NewScalar(ScalarConfig{
+	Name: "CursorKey",
+	Description: "The `CursorKey` scalar type represents an opaque paging cursor: a " +
+		"non-empty, well-formed UTF-8 string without null characters.",
+	Serialize:  coerceCursorKey,
+	ParseValue: coerceCursorKey,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceCursorKey(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,22 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_CursorKey_AcceptsMultiByteStrings(t *testing.T) {
+	if result := graphql.CursorKey.ParseLiteral(&ast.StringValue{Value: "café:日本"}); result != "café:日本" {
+		t.Fatalf("Expected café:日本, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_CursorKey_RejectsMalformedStrings(t *testing.T) {
+	for _, value := range []string{"abc\xff", "\xed\xa0\x80", "a\x00b", ""} {
+		if result := graphql.CursorKey.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
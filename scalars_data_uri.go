@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewDataURIScalar creates a scalar for base64 encoded data URIs of the given
+// media type, such as `data:image/png;base64,...`. Parsed values are the
+// decoded []byte; serializing a []byte yields a data URI. The scalar is named
+// after the media type, such as `DataURIImagePng`.
+func NewDataURIScalar(mediaType string) *Scalar {
+	name := "DataURI" + typeNameFragment(strings.ToLower(mediaType))
+	prefix := "data:" + strings.ToLower(mediaType) + ";base64,"
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if len(value) < len(prefix) || strings.ToLower(value[:len(prefix)]) != prefix {
+				return nil
+			}
+			data, err := base64.StdEncoding.DecodeString(value[len(prefix):])
+			if err != nil {
+				return nil
+			}
+			return data
+		case *string:
+			return parse(*value)
+		}
+		return nil
+	}
+	serialize := func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case []byte:
+			return prefix + base64.StdEncoding.EncodeToString(value)
+		case string:
+			if parse(value) == nil {
+				return nil
+			}
+			return value
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents base64 "+
+			"encoded `%v` data as a data URI.", name, mediaType),
+		Serialize:  serialize,
+		ParseValue: parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
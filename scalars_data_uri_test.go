@@ -0,0 +1,63 @@
+package graphql_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_DataURI_RoundTripsBytes(t *testing.T) {
+	png := graphql.NewDataURIScalar("image/png")
+	data := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+	serialized, ok := png.Serialize(data).(string)
+	if !ok || serialized != "data:image/png;base64,iVBORw0KGgo=" {
+		t.Fatalf("Expected data:image/png;base64,iVBORw0KGgo=, got: %v", serialized)
+	}
+	parsed, ok := png.ParseLiteral(&ast.StringValue{Value: serialized}).([]byte)
+	if !ok || !bytes.Equal(parsed, data) {
+		t.Fatalf("Expected %v, got: %v", data, parsed)
+	}
+}
+
+func TestTypeSystem_Scalar_DataURI_RejectsMismatchedMediaType(t *testing.T) {
+	png := graphql.NewDataURIScalar("image/png")
+	for _, value := range []string{
+		"data:image/jpeg;base64,iVBORw0KGgo=",
+		"data:image/png,iVBORw0KGgo=",
+		"data:image/png;base64,not base64",
+	} {
+		if result := png.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+// assertScalarsCoexist checks that scalars, built by the same constructor
+// with different configurations, have distinct names and can be used
+// together in one schema.
+func assertScalarsCoexist(t *testing.T, scalars ...*graphql.Scalar) {
+	fields := graphql.Fields{}
+	for i, scalar := range scalars {
+		fields[fmt.Sprintf("f%v", i)] = &graphql.Field{Type: scalar}
+	}
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: fields,
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+}
+
+func TestTypeSystem_Scalar_DataURI_NamedAfterMediaType(t *testing.T) {
+	png := graphql.NewDataURIScalar("image/png")
+	if png.Name() != "DataURIImagePng" {
+		t.Fatalf("Expected DataURIImagePng, got: %v", png.Name())
+	}
+	assertScalarsCoexist(t, png, graphql.NewDataURIScalar("image/svg+xml"))
+}
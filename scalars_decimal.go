@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var decimalRegexp = regexp.MustCompile(`^(-?)(\d+)(?:\.(\d+))?$`)
+
+// NewDecimalScalar creates a scalar for decimal numbers with at most scale
+// fractional digits, such as prices. Values are kept as strings to avoid
+// floating point rounding, and are canonicalized to exactly scale fractional
+// digits, so with a scale of 2, `1.9` becomes `"1.90"`.
+func NewDecimalScalar(name string, scale int) *Scalar {
+	if scale < 0 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`%v scale must not be negative, got: %v.`, name, scale)),
+		}
+	}
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			m := decimalRegexp.FindStringSubmatch(value)
+			if m == nil || len(m[3]) > scale {
+				return nil
+			}
+			sign, intPart, fracPart := m[1], strings.TrimLeft(m[2], "0"), m[3]
+			if intPart == "" {
+				intPart = "0"
+			}
+			if scale == 0 {
+				return sign + intPart
+			}
+			return sign + intPart + "." + fracPart + strings.Repeat("0", scale-len(fracPart))
+		case *string:
+			return coerce(*value)
+		case float64:
+			return coerce(strconv.FormatFloat(value, 'f', -1, 64))
+		case float32:
+			return coerce(strconv.FormatFloat(float64(value), 'f', -1, 32))
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return coerce(fmt.Sprintf("%d", value))
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents a decimal number "+
+			"with at most %v fractional digits, serialized as a string.", name, scale),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			case *ast.IntValue:
+				return coerce(valueAST.Value)
+			case *ast.FloatValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Decimal_CanonicalizesScale(t *testing.T) {
+	price := graphql.NewDecimalScalar("Price", 2)
+	if result := price.Serialize("1.9"); result != "1.90" {
+		t.Fatalf("Expected 1.90, got: %v", result)
+	}
+	if result := price.ParseLiteral(&ast.FloatValue{Value: "0012.5"}); result != "12.50" {
+		t.Fatalf("Expected 12.50, got: %v", result)
+	}
+	if result := price.Serialize(3); result != "3.00" {
+		t.Fatalf("Expected 3.00, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Decimal_RejectsExcessScale(t *testing.T) {
+	price := graphql.NewDecimalScalar("Price", 2)
+	for _, value := range []string{"1.999", "1.", ".5", "1e3"} {
+		if result := price.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// doiRegexp matches a DOI: the `10.` directory indicator and a registrant
+// code, then a `/` and a non-empty suffix.
+var doiRegexp = regexp.MustCompile(`^(?i:doi:)?(10\.\d{4,9}(?:\.\d+)*)/(\S+)$`)
+
+func coerceDOI(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		m := doiRegexp.FindStringSubmatch(strings.TrimSpace(value))
+		if m == nil {
+			return nil
+		}
+		// DOIs are case-insensitive; the suffix keeps its case for display.
+		return strings.ToLower(m[1]) + "/" + m[2]
+	case *string:
+		return coerceDOI(*value)
+	}
+	return nil
+}
+
+// DOI is the GraphQL Digital Object Identifier type definition.
+var DOI = NewScalar(ScalarConfig{
+	Name: "DOI",
+	Description: "The `DOI` scalar type represents a Digital Object Identifier, such " +
+		"as `10.1000/xyz123`. Input may carry a `doi:` prefix, which is stripped.",
+	Serialize:  coerceDOI,
+	ParseValue: coerceDOI,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceDOI(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,28 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_DOI_AcceptsValidDOIs(t *testing.T) {
+	for value, expected := range map[string]string{
+		"10.1000/xyz123":        "10.1000/xyz123",
+		"doi:10.1038/nphys1170": "10.1038/nphys1170",
+		"10.1000.10/ABC":        "10.1000.10/ABC",
+	} {
+		if result := graphql.DOI.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_DOI_RejectsInvalidDOIs(t *testing.T) {
+	for _, value := range []string{"not-a-doi", "10.1000/", "11.1000/xyz", "10.12/xyz"} {
+		if result := graphql.DOI.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package graphql
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// EmailComponents holds an email address along with its local part and
+// domain.
+type EmailComponents struct {
+	Full   string
+	Local  string
+	Domain string
+}
+
+// ParseEmail validates a bare email address, such as `a@b.com`, and splits
+// it into its components. Display names and angle brackets are rejected.
+func ParseEmail(email string) (EmailComponents, bool) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Name != "" || addr.Address != email {
+		return EmailComponents{}, false
+	}
+	at := strings.LastIndex(email, "@")
+	return EmailComponents{
+		Full:   email,
+		Local:  email[:at],
+		Domain: email[at+1:],
+	}, true
+}
+
+func serializeEmailParsed(value interface{}) interface{} {
+	switch value := value.(type) {
+	case EmailComponents:
+		return serializeEmailParsed(value.Full)
+	case *EmailComponents:
+		return serializeEmailParsed(*value)
+	case string:
+		if _, ok := ParseEmail(value); !ok {
+			return nil
+		}
+		return value
+	case *string:
+		return serializeEmailParsed(*value)
+	}
+	return nil
+}
+
+func unserializeEmailParsed(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		components, ok := ParseEmail(value)
+		if !ok {
+			return nil
+		}
+		return components
+	case *string:
+		return unserializeEmailParsed(*value)
+	}
+	return nil
+}
+
+// EmailParsed is the GraphQL email address type definition. Parsed values
+// are EmailComponents.
+var EmailParsed = NewScalar(ScalarConfig{
+	Name: "EmailParsed",
+	Description: "The `EmailParsed` scalar type represents an email address such " +
+		"as `user@example.com`, serialized as a string.",
+	Serialize:  serializeEmailParsed,
+	ParseValue: unserializeEmailParsed,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeEmailParsed(valueAST.Value)
+		}
+		return nil
+	},
+})
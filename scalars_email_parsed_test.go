@@ -0,0 +1,30 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_EmailParsed_ExtractsComponents(t *testing.T) {
+	expected := graphql.EmailComponents{Full: "a@b.com", Local: "a", Domain: "b.com"}
+	result := graphql.EmailParsed.ParseLiteral(&ast.StringValue{Value: "a@b.com"})
+	if result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if serialized := graphql.EmailParsed.Serialize(expected); serialized != "a@b.com" {
+		t.Fatalf("Expected a@b.com, got: %v", serialized)
+	}
+	if serialized := graphql.EmailParsed.Serialize("a@b.com"); serialized != "a@b.com" {
+		t.Fatalf("Expected a@b.com, got: %v", serialized)
+	}
+}
+
+func TestTypeSystem_Scalar_EmailParsed_RejectsInvalidAddresses(t *testing.T) {
+	for _, value := range []string{"a.b.com", "Alice <a@b.com>", "a@"} {
+		if result := graphql.EmailParsed.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
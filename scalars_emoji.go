@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// emojiTable approximates the code points with default or common emoji
+// presentation, following the Unicode emoji data files.
+var emojiTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00a9, 0x00ae, 5},
+		{0x203c, 0x2049, 13},
+		{0x2122, 0x2139, 23},
+		{0x2194, 0x2199, 1},
+		{0x21a9, 0x21aa, 1},
+		{0x231a, 0x231b, 1},
+		{0x2328, 0x23cf, 167},
+		{0x23e9, 0x23f3, 1},
+		{0x23f8, 0x23fa, 1},
+		{0x24c2, 0x25aa, 232},
+		{0x25ab, 0x25b6, 11},
+		{0x25c0, 0x25fb, 59},
+		{0x25fc, 0x25fe, 1},
+		{0x2600, 0x27bf, 1},
+		{0x2934, 0x2935, 1},
+		{0x2b05, 0x2b07, 1},
+		{0x2b1b, 0x2b1c, 1},
+		{0x2b50, 0x2b55, 5},
+		{0x3030, 0x303d, 13},
+		{0x3297, 0x3299, 2},
+	},
+	R32: []unicode.Range32{
+		{0x1f004, 0x1f0cf, 203},
+		{0x1f170, 0x1f251, 1},
+		{0x1f300, 0x1f64f, 1},
+		{0x1f680, 0x1f6ff, 1},
+		{0x1f7e0, 0x1f7eb, 1},
+		{0x1f900, 0x1f9ff, 1},
+		{0x1fa70, 0x1faff, 1},
+	},
+	LatinOffset: 1,
+}
+
+// isEmojiModifier reports whether r only modifies or joins emoji: the zero
+// width joiner, variation selectors, the combining keycap, skin tone
+// modifiers and tag characters.
+func isEmojiModifier(r rune) bool {
+	switch {
+	case r == 0x200d, r == 0xfe0e, r == 0xfe0f, r == 0x20e3:
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff:
+		return true
+	case r >= 0xe0020 && r <= 0xe007f:
+		return true
+	}
+	return false
+}
+
+func coerceEmoji(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !utf8.ValidString(value) {
+			return nil
+		}
+		hasEmoji := false
+		for _, r := range value {
+			switch {
+			case unicode.Is(emojiTable, r):
+				hasEmoji = true
+			case isEmojiModifier(r):
+			default:
+				return nil
+			}
+		}
+		if !hasEmoji {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceEmoji(*value)
+	}
+	return nil
+}
+
+// Emoji is the GraphQL emoji-only string type definition.
+var Emoji = NewScalar(ScalarConfig{
+	Name: "Emoji",
+	Description: "The `Emoji` scalar type represents a string made up of one or " +
+		"more emoji only.",
+	Serialize:  coerceEmoji,
+	ParseValue: coerceEmoji,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceEmoji(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,34 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Emoji_AcceptsEmojiOnly(t *testing.T) {
+	for _, value := range []string{
+		"👍",
+		"👍🎉",
+		"👍🏽",
+		"👨‍👩‍👧",
+		"❤️",
+		"🇧🇪",
+	} {
+		if result := graphql.Emoji.ParseValue(value); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.Emoji.ParseLiteral(&ast.StringValue{Value: "🚀"}); result != "🚀" {
+		t.Fatalf("Expected 🚀, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Emoji_RejectsOtherCharacters(t *testing.T) {
+	for _, value := range []string{"a👍", "👍 👍", "", "‍", "1"} {
+		if result := graphql.Emoji.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// etagRegexp matches the entity-tag syntax of RFC 7232: an optional `W/`
+// weak prefix followed by a quoted string of etagc characters.
+var etagRegexp = regexp.MustCompile(`^(W/)?"[\x21\x23-\x7E\x80-\xFF]*"$`)
+
+func coerceETag(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		etag := strings.TrimSpace(value)
+		if !etagRegexp.MatchString(etag) {
+			return nil
+		}
+		return etag
+	case *string:
+		return coerceETag(*value)
+	}
+	return nil
+}
+
+// ETag is the GraphQL HTTP entity tag type definition.
+var ETag = NewScalar(ScalarConfig{
+	Name: "ETag",
+	Description: "The `ETag` scalar type represents an HTTP entity tag, a quoted " +
+		"string with an optional `W/` weak prefix, such as `W/\"abc\"`.",
+	Serialize:  coerceETag,
+	ParseValue: coerceETag,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceETag(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,29 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_ETag_AcceptsStrongAndWeakTags(t *testing.T) {
+	for value, expected := range map[string]string{
+		`W/"abc"`:  `W/"abc"`,
+		`"abc"`:    `"abc"`,
+		` "abc"  `: `"abc"`,
+		`""`:       `""`,
+	} {
+		if result := graphql.ETag.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_ETag_RejectsMalformedTags(t *testing.T) {
+	for _, value := range []string{`abc`, `w/"abc"`, `"a"b"`, `"abc`} {
+		if result := graphql.ETag.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewFileExtensionScalar creates a scalar for file extensions in the allowed
+// list, such as `jpg`. Extensions are normalized to lowercase without a
+// leading dot, so `".JPG"` becomes `"jpg"`. The scalar is named after the
+// allowed extensions, such as `FileExtensionJpgPng`.
+func NewFileExtensionScalar(allowed []string) *Scalar {
+	normalize := func(ext string) string {
+		return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), "."))
+	}
+	allowedSet := map[string]bool{}
+	normalized := []string{}
+	for _, ext := range allowed {
+		allowedSet[normalize(ext)] = true
+		normalized = append(normalized, normalize(ext))
+	}
+	name := "FileExtension" + typeNameFragment(normalized...)
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			ext := normalize(value)
+			if !allowedSet[ext] {
+				return nil
+			}
+			return ext
+		case *string:
+			return coerce(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents one of the file "+
+			"extensions %v, in lowercase without a leading dot.", name, normalized),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_FileExtension_NormalizesExtensions(t *testing.T) {
+	images := graphql.NewFileExtensionScalar([]string{"jpg", ".PNG"})
+	if result := images.ParseLiteral(&ast.StringValue{Value: ".JPG"}); result != "jpg" {
+		t.Fatalf("Expected jpg, got: %v", result)
+	}
+	if result := images.ParseValue("png"); result != "png" {
+		t.Fatalf("Expected png, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_FileExtension_RejectsDisallowedExtensions(t *testing.T) {
+	images := graphql.NewFileExtensionScalar([]string{"jpg", "png"})
+	for _, value := range []string{"exe", ".exe", ""} {
+		if result := images.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_FileExtension_NamedAfterExtensions(t *testing.T) {
+	images := graphql.NewFileExtensionScalar([]string{"jpg", ".PNG"})
+	if images.Name() != "FileExtensionJpgPng" {
+		t.Fatalf("Expected FileExtensionJpgPng, got: %v", images.Name())
+	}
+	assertScalarsCoexist(t, images, graphql.NewFileExtensionScalar([]string{"pdf"}))
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"path"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// coerceFilePath cleans value into a slash-separated path and rejects empty
+// paths, absolute paths, paths starting with a Windows drive letter and
+// paths that escape their root through `..` elements.
+func coerceFilePath(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if value == "" {
+			return nil
+		}
+		cleaned := path.Clean(strings.Replace(value, `\`, "/", -1))
+		if cleaned == ".." || strings.HasPrefix(cleaned, "../") || path.IsAbs(cleaned) || hasDriveLetter(cleaned) {
+			return nil
+		}
+		return cleaned
+	case *string:
+		return coerceFilePath(*value)
+	}
+	return nil
+}
+
+// hasDriveLetter reports whether p starts with a Windows drive letter, such
+// as `C:`.
+func hasDriveLetter(p string) bool {
+	if len(p) < 2 || p[1] != ':' {
+		return false
+	}
+	c := p[0]
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// FilePath is the GraphQL file path type definition.
+var FilePath = NewScalar(ScalarConfig{
+	Name: "FilePath",
+	Description: "The `FilePath` scalar type represents a cleaned, slash-separated " +
+		"relative file path that does not escape its root directory.",
+	Serialize:  coerceFilePath,
+	ParseValue: coerceFilePath,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceFilePath(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,39 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_FilePath_NormalizesPath(t *testing.T) {
+	if result := graphql.FilePath.ParseValue("a/../b"); result != "b" {
+		t.Fatalf("Expected b, got: %v", result)
+	}
+	if result := graphql.FilePath.ParseLiteral(&ast.StringValue{Value: `dir\sub//file.txt`}); result != "dir/sub/file.txt" {
+		t.Fatalf("Expected dir/sub/file.txt, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_FilePath_RejectsTraversalAndEmptyPaths(t *testing.T) {
+	for _, value := range []string{"../etc/passwd", "a/../../b", ".."} {
+		if result := graphql.FilePath.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.FilePath.ParseValue(""); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_FilePath_RejectsAbsolutePaths(t *testing.T) {
+	for _, value := range []string{"/etc/passwd", "/../etc/passwd", `\windows\system32`, `\\server\share`, `C:\Windows`, "c:/windows", "C:file.txt"} {
+		if result := graphql.FilePath.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.FilePath.ParseValue("dir/c:file.txt"); result != "dir/c:file.txt" {
+		t.Fatalf("Expected dir/c:file.txt, got: %v", result)
+	}
+}
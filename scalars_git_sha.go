@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var gitSHARegexp = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+func coerceGitSHA(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		sha := strings.ToLower(value)
+		if !gitSHARegexp.MatchString(sha) {
+			return nil
+		}
+		return sha
+	case *string:
+		return coerceGitSHA(*value)
+	}
+	return nil
+}
+
+// GitSHA is the GraphQL git commit hash type definition.
+var GitSHA = NewScalar(ScalarConfig{
+	Name: "GitSHA",
+	Description: "The `GitSHA` scalar type represents a full or abbreviated git " +
+		"object hash of 7 to 40 lowercase hexadecimal characters.",
+	Serialize:  coerceGitSHA,
+	ParseValue: coerceGitSHA,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceGitSHA(valueAST.Value)
+		}
+		return nil
+	},
+})
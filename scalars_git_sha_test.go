@@ -0,0 +1,30 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_GitSHA_AcceptsFullAndAbbreviatedHashes(t *testing.T) {
+	full := "f5bef4e0c1d2a3b4c5d6e7f8091a2b3c4d5e6f70"
+	if result := graphql.GitSHA.ParseValue(full); result != full {
+		t.Fatalf("Expected %v, got: %v", full, result)
+	}
+	if result := graphql.GitSHA.ParseLiteral(&ast.StringValue{Value: "F5BEF4E"}); result != "f5bef4e" {
+		t.Fatalf("Expected f5bef4e, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_GitSHA_RejectsInvalidHashes(t *testing.T) {
+	for _, value := range []string{
+		"f5bef4e0c1d2a3b4c5d6e7f8091a2b3c4d5e6f701",
+		"f5bef4",
+		"g5bef4e",
+	} {
+		if result := graphql.GitSHA.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// graphemeCount approximates the number of user-perceived characters in s,
+// following the main rules of Unicode text segmentation (UAX #29): combining
+// marks and emoji modifiers join the preceding character, emoji joined by a
+// zero width joiner form one cluster, regional indicators pair up into flags
+// and CR LF counts once. Hangul jamo sequences are not joined.
+func graphemeCount(s string) int {
+	count := 0
+	prev := rune(-1)
+	regionalIndicators := 0
+	for _, r := range s {
+		joins := false
+		switch {
+		case prev < 0:
+		case prev == '\r' && r == '\n':
+			joins = true
+		case prev == '\r' || prev == '\n' || unicode.IsControl(prev):
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) || isEmojiModifier(r):
+			joins = true
+		case prev == 0x200d && unicode.Is(emojiTable, r):
+			joins = true
+		case isRegionalIndicator(r) && regionalIndicators%2 == 1:
+			joins = true
+		}
+		if !joins {
+			count++
+		}
+		if isRegionalIndicator(r) {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+		prev = r
+	}
+	return count
+}
+
+// NewGraphemeBoundedStringScalar creates a string scalar that rejects text
+// of more than max user-perceived characters, so that emoji and accented
+// letters made of several code points count once.
+func NewGraphemeBoundedStringScalar(name string, max int) *Scalar {
+	if max < 0 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`%v maximum length must not be negative, got: %v.`, name, max)),
+		}
+	}
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if !utf8.ValidString(value) || graphemeCount(value) > max {
+				return nil
+			}
+			return value
+		case *string:
+			return coerce(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents text of at most %v "+
+			"user-perceived characters.", name, max),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_GraphemeBoundedString_CountsClusters(t *testing.T) {
+	single := graphql.NewGraphemeBoundedStringScalar("Initial", 1)
+	for _, value := range []string{
+		"\U0001F468\u200d\U0001F469\u200d\U0001F467\u200d\U0001F466", // family
+		"\U0001F44D\U0001F3FD", // thumbs up with skin tone
+		"\U0001F1EB\U0001F1F7", // flag
+		"e\u0301",              // accented letter
+	} {
+		if result := single.ParseLiteral(&ast.StringValue{Value: value}); result != value {
+			t.Fatalf("Expected %q to count as one cluster, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_GraphemeBoundedString_RejectsLongerText(t *testing.T) {
+	short := graphql.NewGraphemeBoundedStringScalar("Short", 2)
+	for _, value := range []string{"abc", "\U0001F1EB\U0001F1F7\U0001F1E9\U0001F1EA\U0001F1EE\U0001F1F9"} {
+		if result := short.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+	if result := short.ParseValue("e\u0301e\u0301"); result != "e\u0301e\u0301" {
+		t.Fatalf("Expected two clusters to be accepted, got: %v", result)
+	}
+}
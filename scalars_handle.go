@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var handleRegexp = regexp.MustCompile(`^(?:([A-Za-z]+):)?@?([A-Za-z0-9_.]{1,30})$`)
+
+func coerceHandle(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		m := handleRegexp.FindStringSubmatch(value)
+		if m == nil {
+			return nil
+		}
+		if m[1] != "" {
+			return strings.ToLower(m[1]) + ":@" + m[2]
+		}
+		return "@" + m[2]
+	case *string:
+		return coerceHandle(*value)
+	}
+	return nil
+}
+
+// Handle is the GraphQL user handle type definition.
+var Handle = NewScalar(ScalarConfig{
+	Name: "Handle",
+	Description: "The `Handle` scalar type represents a user handle of 1 to 30 " +
+		"letters, digits, underscores and dots prefixed with `@`, such as `@user`, " +
+		"optionally qualified by a platform, such as `github:@user`. Input may " +
+		"omit the `@`.",
+	Serialize:  coerceHandle,
+	ParseValue: coerceHandle,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceHandle(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,30 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Handle_NormalizesHandles(t *testing.T) {
+	for value, expected := range map[string]string{
+		"user":         "@user",
+		"@user.name":   "@user.name",
+		"GitHub:@user": "github:@user",
+		"github:user":  "github:@user",
+	} {
+		if result := graphql.Handle.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Handle_RejectsInvalidHandles(t *testing.T) {
+	for _, value := range []string{"@bad handle", "@", "@" + strings.Repeat("a", 31), "@@user"} {
+		if result := graphql.Handle.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
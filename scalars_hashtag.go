@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var hashtagRegexp = regexp.MustCompile(`^#?([\pL\pN_]+)$`)
+
+func coerceHashtag(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		m := hashtagRegexp.FindStringSubmatch(value)
+		if m == nil {
+			return nil
+		}
+		return "#" + strings.ToLower(m[1])
+	case *string:
+		return coerceHashtag(*value)
+	}
+	return nil
+}
+
+// Hashtag is the GraphQL hashtag type definition.
+var Hashtag = NewScalar(ScalarConfig{
+	Name: "Hashtag",
+	Description: "The `Hashtag` scalar type represents a lowercase tag of letters, " +
+		"digits and underscores prefixed with `#`, such as `#golang`. Input may " +
+		"omit the leading `#`.",
+	Serialize:  coerceHashtag,
+	ParseValue: coerceHashtag,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceHashtag(valueAST.Value)
+		}
+		return nil
+	},
+})
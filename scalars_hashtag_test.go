@@ -0,0 +1,28 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Hashtag_NormalizesTags(t *testing.T) {
+	for value, expected := range map[string]string{
+		"golang":   "#golang",
+		"#GoLang":  "#golang",
+		"#go_1_21": "#go_1_21",
+	} {
+		if result := graphql.Hashtag.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Hashtag_RejectsInvalidTags(t *testing.T) {
+	for _, value := range []string{"#bad tag", "#", "##golang", "go-lang"} {
+		if result := graphql.Hashtag.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
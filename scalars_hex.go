@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewHexScalar creates a scalar for hex encoded values of exactly byteLen
+// bytes, such as a 32 byte hash. Parsed values are []byte, serialized values
+// are lowercase hex strings.
+func NewHexScalar(name string, byteLen int) *Scalar {
+	if byteLen <= 0 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`%v byte length must be positive, got: %v.`, name, byteLen)),
+		}
+	}
+	var decode func(value interface{}) interface{}
+	decode = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if len(value) != 2*byteLen {
+				return nil
+			}
+			b, err := hex.DecodeString(value)
+			if err != nil {
+				return nil
+			}
+			return b
+		case *string:
+			return decode(*value)
+		case []byte:
+			if len(value) != byteLen {
+				return nil
+			}
+			return value
+		}
+		return nil
+	}
+	serialize := func(value interface{}) interface{} {
+		b, ok := decode(value).([]byte)
+		if !ok {
+			return nil
+		}
+		return hex.EncodeToString(b)
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents %v bytes encoded "+
+			"as %v hexadecimal characters.", name, byteLen, 2*byteLen),
+		Serialize:  serialize,
+		ParseValue: decode,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return decode(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
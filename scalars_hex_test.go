@@ -0,0 +1,34 @@
+package graphql_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Hex_AcceptsExactLength(t *testing.T) {
+	hash := graphql.NewHexScalar("SHA256", 32)
+	value := strings.Repeat("aB", 32)
+	result, ok := hash.ParseLiteral(&ast.StringValue{Value: value}).([]byte)
+	if !ok || !bytes.Equal(result, bytes.Repeat([]byte{0xab}, 32)) {
+		t.Fatalf("Expected 32 bytes of 0xab, got: %v", result)
+	}
+	if serialized := hash.Serialize(result); serialized != strings.ToLower(value) {
+		t.Fatalf("Expected %v, got: %v", strings.ToLower(value), serialized)
+	}
+}
+
+func TestTypeSystem_Scalar_Hex_RejectsInvalidValues(t *testing.T) {
+	hash := graphql.NewHexScalar("SHA256", 32)
+	for _, value := range []string{
+		strings.Repeat("a", 63),
+		strings.Repeat("a", 62) + "zz",
+	} {
+		if result := hash.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package graphql
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var httpMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodConnect: true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+}
+
+func coerceHTTPMethod(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		method := strings.ToUpper(value)
+		if httpMethods[method] {
+			return method
+		}
+	case *string:
+		return coerceHTTPMethod(*value)
+	}
+	return nil
+}
+
+// HTTPMethod is the GraphQL HTTP request method type definition.
+var HTTPMethod = NewScalar(ScalarConfig{
+	Name: "HTTPMethod",
+	Description: "The `HTTPMethod` scalar type represents one of the standard HTTP " +
+		"request methods, such as `GET` or `POST`, in uppercase.",
+	Serialize:  coerceHTTPMethod,
+	ParseValue: coerceHTTPMethod,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceHTTPMethod(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,23 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_HTTPMethod_UppercasesKnownMethod(t *testing.T) {
+	if result := graphql.HTTPMethod.ParseValue("get"); result != "GET" {
+		t.Fatalf("Expected GET, got: %v", result)
+	}
+	if result := graphql.HTTPMethod.ParseLiteral(&ast.StringValue{Value: "Patch"}); result != "PATCH" {
+		t.Fatalf("Expected PATCH, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_HTTPMethod_RejectsUnknownMethod(t *testing.T) {
+	if result := graphql.HTTPMethod.ParseValue("FETCH"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
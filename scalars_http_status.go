@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceHTTPStatusCode(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		// Accept a trailing reason phrase, as in `"200 OK"`.
+		if i := strings.IndexByte(s, ' '); i >= 0 {
+			value = s[:i]
+		}
+	}
+	code, ok := coerceInt(value).(int)
+	if !ok || code < 100 || code > 599 {
+		return nil
+	}
+	return code
+}
+
+// NewHTTPStatusScalar creates an `HTTPStatus` scalar for HTTP status codes
+// from 100 to 599. Parsed values are ints. When withReason is set, codes
+// are serialized with their standard reason phrase, such as `"200 OK"`;
+// otherwise they are serialized as bare ints and the scalar is named
+// `HTTPStatusCode`.
+func NewHTTPStatusScalar(withReason bool) *Scalar {
+	name := "HTTPStatusCode"
+	serialize := coerceHTTPStatusCode
+	if withReason {
+		name = "HTTPStatus"
+	}
+	description := "The `" + name + "` scalar type represents an HTTP status " +
+		"code from 100 to 599."
+	if withReason {
+		serialize = func(value interface{}) interface{} {
+			code, ok := coerceHTTPStatusCode(value).(int)
+			if !ok {
+				return nil
+			}
+			if reason := http.StatusText(code); reason != "" {
+				return fmt.Sprintf("%d %v", code, reason)
+			}
+			return strconv.Itoa(code)
+		}
+		description += " It is serialized along with its reason phrase, such as `\"200 OK\"`."
+	}
+	return NewScalar(ScalarConfig{
+		Name:        name,
+		Description: description,
+		Serialize:   serialize,
+		ParseValue:  coerceHTTPStatusCode,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.IntValue:
+				return coerceHTTPStatusCode(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
+
+// HTTPStatus is the GraphQL HTTP status code type definition, serialized
+// with its reason phrase.
+var HTTPStatus = NewHTTPStatusScalar(true)
@@ -0,0 +1,39 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_HTTPStatus_SerializesWithReason(t *testing.T) {
+	if result := graphql.HTTPStatus.Serialize(200); result != "200 OK" {
+		t.Fatalf("Expected 200 OK, got: %v", result)
+	}
+	if result := graphql.NewHTTPStatusScalar(false).Serialize(404); result != 404 {
+		t.Fatalf("Expected 404, got: %v", result)
+	}
+	if result := graphql.HTTPStatus.ParseValue("404 Not Found"); result != 404 {
+		t.Fatalf("Expected 404, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_HTTPStatus_RejectsOutOfRangeCodes(t *testing.T) {
+	for _, value := range []string{"700", "99"} {
+		if result := graphql.HTTPStatus.ParseLiteral(&ast.IntValue{Value: value}); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+	if result := graphql.HTTPStatus.Serialize(700); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_HTTPStatus_NamesBareCodeVariant(t *testing.T) {
+	codes := graphql.NewHTTPStatusScalar(false)
+	if codes.Name() != "HTTPStatusCode" {
+		t.Fatalf("Expected HTTPStatusCode, got: %v", codes.Name())
+	}
+	assertScalarsCoexist(t, graphql.HTTPStatus, codes)
+}
@@ -0,0 +1,33 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_IBAN_AcceptsAndNormalizesValidIBAN(t *testing.T) {
+	if result := graphql.IBAN.ParseLiteral(&ast.StringValue{Value: "gb82 west 1234 5698 7654 32"}); result != "GB82WEST12345698765432" {
+		t.Fatalf("Expected GB82WEST12345698765432, got: %v", result)
+	}
+	if result := graphql.IBAN.ParseValue("DE89370400440532013000"); result != "DE89370400440532013000" {
+		t.Fatalf("Expected DE89370400440532013000, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_IBAN_RejectsInvalidIBAN(t *testing.T) {
+	for _, value := range []string{
+		"GB83WEST12345698765432",
+		"GB82WEST1234569876543",
+		"XX82WEST12345698765432",
+	} {
+		if result := graphql.IBAN.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_IBAN_RedactsNumberInErrors(t *testing.T) {
+	assertRedactedInErrors(t, graphql.IBAN, "GB82WEST12345698765431")
+}
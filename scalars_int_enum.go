@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewIntEnumScalar creates an integer scalar that only accepts the allowed
+// values, such as a fixed set of status codes.
+func NewIntEnumScalar(name string, allowed []int) *Scalar {
+	allowedSet := map[int]bool{}
+	for _, v := range allowed {
+		allowedSet[v] = true
+	}
+	coerce := func(value interface{}) interface{} {
+		i, ok := coerceInt(value).(int)
+		if !ok || !allowedSet[i] {
+			return nil
+		}
+		return i
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents one of the integers %v.",
+			name, allowed),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.IntValue:
+				if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
+					return coerce(intValue)
+				}
+			}
+			return nil
+		},
+	})
+}
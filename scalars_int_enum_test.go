@@ -0,0 +1,28 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_IntEnum_AcceptsAllowedValue(t *testing.T) {
+	status := graphql.NewIntEnumScalar("Status", []int{200, 404, 500})
+	if result := status.ParseValue(200); result != 200 {
+		t.Fatalf("Expected 200, got: %v", result)
+	}
+	if result := status.ParseLiteral(&ast.IntValue{Value: "404"}); result != 404 {
+		t.Fatalf("Expected 404, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_IntEnum_RejectsValueOutsideSet(t *testing.T) {
+	status := graphql.NewIntEnumScalar("Status", []int{200, 404, 500})
+	if result := status.ParseValue(299); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := status.ParseLiteral(&ast.IntValue{Value: "299"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewIntToNameScalar creates a scalar that represents integers by name, such
+// as a status code stored as an int but exposed as `"ACTIVE"`. Serialize
+// looks the integer up in mapping, falling back to its decimal form for
+// unknown integers; parsing maps names back to their integer.
+func NewIntToNameScalar(name string, mapping map[int]string) *Scalar {
+	reverse := map[string]int{}
+	for i, n := range mapping {
+		reverse[n] = i
+	}
+	serialize := func(value interface{}) interface{} {
+		i, ok := coerceInt(value).(int)
+		if !ok {
+			return nil
+		}
+		if n, ok := mapping[i]; ok {
+			return n
+		}
+		return strconv.Itoa(i)
+	}
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if i, ok := reverse[value]; ok {
+				return i
+			}
+		case *string:
+			return parse(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents an integer value " +
+			"by its name.",
+		Serialize:  serialize,
+		ParseValue: parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			case *ast.EnumValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
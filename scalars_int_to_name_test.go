@@ -0,0 +1,33 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var statusMapping = map[int]string{0: "INACTIVE", 1: "ACTIVE"}
+
+func TestTypeSystem_Scalar_IntToName_SerializesName(t *testing.T) {
+	status := graphql.NewIntToNameScalar("Status", statusMapping)
+	if result := status.Serialize(1); result != "ACTIVE" {
+		t.Fatalf("Expected ACTIVE, got: %v", result)
+	}
+	if result := status.Serialize(7); result != "7" {
+		t.Fatalf("Expected 7, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_IntToName_ParsesName(t *testing.T) {
+	status := graphql.NewIntToNameScalar("Status", statusMapping)
+	if result := status.ParseValue("ACTIVE"); result != 1 {
+		t.Fatalf("Expected 1, got: %v", result)
+	}
+	if result := status.ParseLiteral(&ast.EnumValue{Value: "INACTIVE"}); result != 0 {
+		t.Fatalf("Expected 0, got: %v", result)
+	}
+	if result := status.ParseValue("UNKNOWN"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
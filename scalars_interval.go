@@ -0,0 +1,141 @@
+package graphql
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// IntervalValue is the value of the Interval scalar.
+type IntervalValue struct {
+	Start time.Time
+	End   time.Time
+}
+
+// intervalInstantLayouts are the ISO 8601 instant layouts accepted by the
+// Interval scalar, from most to least precise.
+var intervalInstantLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02",
+}
+
+// isoDurationRegexp matches ISO 8601 durations such as `P1Y2M3DT4H5M6S` or
+// `P2W`.
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?` +
+	`(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+func parseIntervalInstant(s string) (time.Time, bool) {
+	for _, layout := range intervalInstantLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// addISODuration adds the ISO 8601 duration d to start. Durations with
+// components too large to add are rejected rather than wrapping around.
+func addISODuration(start time.Time, d string) (time.Time, bool) {
+	match := isoDurationRegexp.FindStringSubmatch(d)
+	if match == nil || d == "P" || strings.HasSuffix(d, "T") {
+		return time.Time{}, false
+	}
+	components := [6]int64{}
+	for i := range components {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(match[i+1], 10, 32)
+		if err != nil {
+			return time.Time{}, false
+		}
+		components[i] = n
+	}
+	years, months, weeks, days, hours, minutes := components[0], components[1],
+		components[2], components[3], components[4], components[5]
+	days += weeks * 7
+	if days > math.MaxInt32 {
+		return time.Time{}, false
+	}
+	end := start.AddDate(int(years), int(months), int(days))
+
+	if hours > int64(math.MaxInt64/time.Hour) {
+		return time.Time{}, false
+	}
+	offset := time.Duration(hours) * time.Hour
+	if minutes > int64((math.MaxInt64-offset)/time.Minute) {
+		return time.Time{}, false
+	}
+	offset += time.Duration(minutes) * time.Minute
+	if match[7] != "" {
+		seconds, err := strconv.ParseFloat(match[7], 64)
+		nanoseconds := seconds * float64(time.Second)
+		if err != nil || nanoseconds >= float64(math.MaxInt64) ||
+			time.Duration(nanoseconds) > math.MaxInt64-offset {
+			return time.Time{}, false
+		}
+		offset += time.Duration(nanoseconds)
+	}
+	return end.Add(offset), true
+}
+
+func parseInterval(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		parts := strings.Split(value, "/")
+		if len(parts) != 2 {
+			return nil
+		}
+		start, ok := parseIntervalInstant(parts[0])
+		if !ok {
+			return nil
+		}
+		end, ok := parseIntervalInstant(parts[1])
+		if !ok {
+			if end, ok = addISODuration(start, parts[1]); !ok {
+				return nil
+			}
+		}
+		return parseInterval(IntervalValue{Start: start, End: end})
+	case *string:
+		return parseInterval(*value)
+	case IntervalValue:
+		if value.End.Before(value.Start) {
+			return nil
+		}
+		return value
+	case *IntervalValue:
+		return parseInterval(*value)
+	}
+	return nil
+}
+
+func serializeInterval(value interface{}) interface{} {
+	interval, ok := parseInterval(value).(IntervalValue)
+	if !ok {
+		return nil
+	}
+	return interval.Start.Format(time.RFC3339Nano) + "/" + interval.End.Format(time.RFC3339Nano)
+}
+
+// Interval is the GraphQL ISO 8601 time interval type definition.
+var Interval = NewScalar(ScalarConfig{
+	Name: "Interval",
+	Description: "The `Interval` scalar type represents an ISO 8601 time interval, " +
+		"given as `start/end` or `start/duration`. The Interval is serialized as " +
+		"two RFC 3339 date-times separated by a slash.",
+	Serialize:  serializeInterval,
+	ParseValue: parseInterval,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return parseInterval(valueAST.Value)
+		}
+		return nil
+	},
+})
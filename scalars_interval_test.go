@@ -0,0 +1,51 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Interval_ParsesStartEnd(t *testing.T) {
+	expected := graphql.IntervalValue{
+		Start: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	result, ok := graphql.Interval.ParseValue("2023-01-01T00:00Z/2023-01-02T00:00Z").(graphql.IntervalValue)
+	if !ok || !result.Start.Equal(expected.Start) || !result.End.Equal(expected.End) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := graphql.Interval.Serialize(expected); result != "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z" {
+		t.Fatalf("Expected serialized interval, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Interval_ParsesStartDuration(t *testing.T) {
+	expected := time.Date(2023, 2, 2, 12, 30, 0, 0, time.UTC)
+	result, ok := graphql.Interval.ParseLiteral(&ast.StringValue{Value: "2023-01-01T00:00:00Z/P1M1DT12H30M"}).(graphql.IntervalValue)
+	if !ok || !result.End.Equal(expected) {
+		t.Fatalf("Expected end %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_Interval_RejectsInvalidIntervals(t *testing.T) {
+	for _, value := range []string{
+		"2023-01-02T00:00Z/2023-01-01T00:00Z",
+		"2023-01-01T00:00Z",
+		"2023-01-01T00:00Z/P",
+		"2023-01-01T00:00Z/PT",
+		"yesterday/today",
+		"2023-01-01T00:00Z/P99999999999999999999D",
+		"2023-01-01T00:00Z/P2147483647W",
+		"2023-01-01T00:00Z/PT3000000H",
+		"2023-01-01T00:00Z/PT2500000H10000000M",
+		"2023-01-01T00:00Z/PT10000000000S",
+		"2023-01-01T00:00Z/PT2500000H300000000S",
+	} {
+		if result := graphql.Interval.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
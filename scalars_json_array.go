@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"reflect"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceJSONArray(value interface{}) interface{} {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		return value
+	case reflect.Array:
+		return value
+	}
+	return nil
+}
+
+// JSONArray is the GraphQL JSON array type definition. Unlike a list type,
+// its elements may be any JSON value.
+var JSONArray = NewScalar(ScalarConfig{
+	Name: "JSONArray",
+	Description: "The `JSONArray` scalar type represents a JSON array whose " +
+		"elements may be any JSON value.",
+	Serialize:  coerceJSONArray,
+	ParseValue: coerceJSONArray,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.ListValue:
+			if value, ok := jsonValueFromAST(valueAST); ok {
+				return value
+			}
+		}
+		return nil
+	},
+})
@@ -0,0 +1,38 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_JSONArray_AcceptsListLiterals(t *testing.T) {
+	list := &ast.ListValue{Values: []ast.Value{
+		&ast.StringValue{Value: "a"},
+		&ast.IntValue{Value: "1"},
+		&ast.ListValue{Values: []ast.Value{&ast.BooleanValue{Value: true}}},
+	}}
+	expected := []interface{}{"a", 1, []interface{}{true}}
+	if result := graphql.JSONArray.ParseLiteral(list); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := graphql.JSONArray.ParseValue(expected); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_JSONArray_RejectsObjectsAndScalars(t *testing.T) {
+	object := &ast.ObjectValue{Fields: []*ast.ObjectField{
+		{Name: &ast.Name{Value: "a"}, Value: &ast.IntValue{Value: "1"}},
+	}}
+	for _, value := range []ast.Value{object, &ast.StringValue{Value: "[]"}} {
+		if result := graphql.JSONArray.ParseLiteral(value); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+	if result := graphql.JSONArray.ParseValue(map[string]interface{}{"a": 1}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
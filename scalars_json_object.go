@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"reflect"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceJSONObject(value interface{}) interface{} {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		return value
+	}
+	return nil
+}
+
+// JSONObject is the GraphQL JSON object type definition. Unlike an input
+// object type, its fields are not declared and may hold any JSON value.
+var JSONObject = NewScalar(ScalarConfig{
+	Name: "JSONObject",
+	Description: "The `JSONObject` scalar type represents a JSON object whose " +
+		"fields may hold any JSON value.",
+	Serialize:  coerceJSONObject,
+	ParseValue: coerceJSONObject,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.ObjectValue:
+			if value, ok := jsonValueFromAST(valueAST); ok {
+				return value
+			}
+		}
+		return nil
+	},
+})
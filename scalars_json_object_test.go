@@ -0,0 +1,35 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_JSONObject_AcceptsObjectLiterals(t *testing.T) {
+	object := &ast.ObjectValue{Fields: []*ast.ObjectField{
+		{Name: &ast.Name{Value: "theme"}, Value: &ast.StringValue{Value: "dark"}},
+		{Name: &ast.Name{Value: "sizes"}, Value: &ast.ListValue{Values: []ast.Value{&ast.IntValue{Value: "1"}}}},
+	}}
+	expected := map[string]interface{}{"theme": "dark", "sizes": []interface{}{1}}
+	if result := graphql.JSONObject.ParseLiteral(object); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := graphql.JSONObject.ParseValue(expected); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_JSONObject_RejectsListsAndScalars(t *testing.T) {
+	list := &ast.ListValue{Values: []ast.Value{&ast.IntValue{Value: "1"}}}
+	for _, value := range []ast.Value{list, &ast.StringValue{Value: "{}"}} {
+		if result := graphql.JSONObject.ParseLiteral(value); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+	if result := graphql.JSONObject.ParseValue([]interface{}{1}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"regexp"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// jsonPointerRegexp matches RFC 6901 pointers, in which every reference
+// token starts with `/` and `~` is only used in the escapes `~0` and `~1`.
+var jsonPointerRegexp = regexp.MustCompile(`^(/([^/~]|~[01])*)*$`)
+
+func coerceJSONPointer(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !jsonPointerRegexp.MatchString(value) {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceJSONPointer(*value)
+	}
+	return nil
+}
+
+// JSONPointer is the GraphQL JSON Pointer type definition.
+var JSONPointer = NewScalar(ScalarConfig{
+	Name: "JSONPointer",
+	Description: "The `JSONPointer` scalar type represents a JSON Pointer as " +
+		"specified by [RFC 6901](https://tools.ietf.org/html/rfc6901), such as " +
+		"`/foo/0/bar`.",
+	Serialize:  coerceJSONPointer,
+	ParseValue: coerceJSONPointer,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceJSONPointer(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,24 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_JSONPointer_AcceptsValidPointers(t *testing.T) {
+	for _, value := range []string{"/a~1b", "/foo/0/bar", "", "/", "/m~0n"} {
+		if result := graphql.JSONPointer.ParseLiteral(&ast.StringValue{Value: value}); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_JSONPointer_RejectsInvalidPointers(t *testing.T) {
+	for _, value := range []string{"no-leading-slash", "/a~2b", "/a~"} {
+		if result := graphql.JSONPointer.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
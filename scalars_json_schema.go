@@ -0,0 +1,192 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// jsonSchema is the subset of JSON Schema understood by the built-in
+// validator of NewJSONSchemaScalar.
+type jsonSchema struct {
+	Type                 interface{}            `json:"type"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	Enum                 []interface{}          `json:"enum"`
+	MinLength            *int                   `json:"minLength"`
+	MaxLength            *int                   `json:"maxLength"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+}
+
+func jsonTypeOf(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if value == math.Trunc(value) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return ""
+}
+
+func (s *jsonSchema) allowsType(value interface{}) bool {
+	types := []string{}
+	switch t := s.Type.(type) {
+	case nil:
+		return true
+	case string:
+		types = append(types, t)
+	case []interface{}:
+		for _, t := range t {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+	}
+	actual := jsonTypeOf(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checks value, as decoded by encoding/json, against s. The
+// returned error names the offending location as a JSON path.
+func (s *jsonSchema) validate(path string, value interface{}) error {
+	if !s.allowsType(value) {
+		return fmt.Errorf("%v: expected type %v, found %v", path, s.Type, jsonTypeOf(value))
+	}
+	if len(s.Enum) > 0 {
+		found := false
+		for _, option := range s.Enum {
+			if reflect.DeepEqual(option, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%v: value is not one of %v", path, s.Enum)
+		}
+	}
+	switch value := value.(type) {
+	case string:
+		length := len([]rune(value))
+		if s.MinLength != nil && length < *s.MinLength {
+			return fmt.Errorf("%v: shorter than %v characters", path, *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return fmt.Errorf("%v: longer than %v characters", path, *s.MaxLength)
+		}
+	case float64:
+		if s.Minimum != nil && value < *s.Minimum {
+			return fmt.Errorf("%v: less than %v", path, *s.Minimum)
+		}
+		if s.Maximum != nil && value > *s.Maximum {
+			return fmt.Errorf("%v: greater than %v", path, *s.Maximum)
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, item := range value {
+				if err := s.Items.validate(fmt.Sprintf("%v[%v]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := value[name]; !ok {
+				return fmt.Errorf("%v: missing required property %q", path, name)
+			}
+		}
+		// to ensure a stable order of property validation
+		names := []string{}
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			propertySchema, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return fmt.Errorf("%v: unexpected property %q", path, name)
+				}
+				continue
+			}
+			if err := propertySchema.validate(path+"."+name, value[name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// NewJSONSchemaScalar creates a JSON scalar whose values must conform to
+// schema. The built-in validator supports the `type`, `enum`, `properties`,
+// `required`, `additionalProperties`, `items`, `minLength`, `maxLength`,
+// `minimum` and `maximum` keywords; other keywords are ignored. Use
+// NewJSONSchemaScalarWithValidator to plug in a complete implementation.
+func NewJSONSchemaScalar(name string, schema []byte) *Scalar {
+	s := &jsonSchema{}
+	if err := json.Unmarshal(schema, s); err != nil {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`%v has an invalid JSON schema: %v`, name, err)),
+		}
+	}
+	return NewJSONSchemaScalarWithValidator(name, func(value interface{}) error {
+		return s.validate("$", value)
+	})
+}
+
+// NewJSONSchemaScalarWithValidator creates a JSON scalar whose values must be
+// accepted by validate. Values are normalized through encoding/json before
+// validation, so validate receives maps, slices, strings, float64s, bools
+// and nils only.
+func NewJSONSchemaScalarWithValidator(name string, validate func(value interface{}) error) *Scalar {
+	coerce := func(value interface{}) interface{} {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			return nil
+		}
+		if decoded == nil || validate(decoded) != nil {
+			return nil
+		}
+		return decoded
+	}
+	return NewScalar(ScalarConfig{
+		Name:        name,
+		Description: "The `" + name + "` scalar type represents JSON data conforming to a JSON schema.",
+		Serialize:   coerce,
+		ParseValue:  coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			value, ok := jsonValueFromAST(valueAST)
+			if !ok {
+				return nil
+			}
+			return coerce(value)
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package graphql_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var personSchema = []byte(`{
+	"type": "object",
+	"required": ["name"],
+	"properties": {
+		"name": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0}
+	}
+}`)
+
+func TestTypeSystem_Scalar_JSONSchema_AcceptsConformingValue(t *testing.T) {
+	person := graphql.NewJSONSchemaScalar("Person", personSchema)
+	if err := person.Error(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{"name": "Luke", "age": 19.0}
+	if result := person.ParseValue(map[string]interface{}{"name": "Luke", "age": 19}); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	literal := &ast.ObjectValue{Fields: []*ast.ObjectField{
+		{Name: &ast.Name{Value: "name"}, Value: &ast.StringValue{Value: "Luke"}},
+		{Name: &ast.Name{Value: "age"}, Value: &ast.IntValue{Value: "19"}},
+	}}
+	if result := person.ParseLiteral(literal); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_JSONSchema_RejectsViolations(t *testing.T) {
+	person := graphql.NewJSONSchemaScalar("Person", personSchema)
+	for _, value := range []interface{}{
+		map[string]interface{}{"age": 19},
+		map[string]interface{}{"name": 42},
+		map[string]interface{}{"name": "Luke", "age": -1},
+		[]interface{}{"Luke"},
+	} {
+		if result := person.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_JSONSchema_PluggableValidator(t *testing.T) {
+	anything := graphql.NewJSONSchemaScalarWithValidator("Anything", func(value interface{}) error {
+		if _, ok := value.(string); ok {
+			return errors.New("strings are not allowed")
+		}
+		return nil
+	})
+	if result := anything.ParseValue(true); result != true {
+		t.Fatalf("Expected true, got: %v", result)
+	}
+	if result := anything.ParseValue("nope"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_JSONSchema_InvalidSchemaIsAnError(t *testing.T) {
+	if graphql.NewJSONSchemaScalar("Broken", []byte(`{`)).Error() == nil {
+		t.Fatalf("Expected an error for an invalid schema")
+	}
+}
@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// formatLocaleNumber formats f with the given separators, grouping the
+// integer part in thousands.
+func formatLocaleNumber(f float64, decimalSep, thousandSep string) string {
+	formatted := strconv.FormatFloat(f, 'f', -1, 64)
+	sign := ""
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+	intPart, fracPart := formatted, ""
+	if i := strings.Index(formatted, "."); i >= 0 {
+		intPart, fracPart = formatted[:i], formatted[i+1:]
+	}
+	groups := []string{}
+	for len(intPart) > 3 {
+		groups = append([]string{intPart[len(intPart)-3:]}, groups...)
+		intPart = intPart[:len(intPart)-3]
+	}
+	groups = append([]string{intPart}, groups...)
+	result := sign + strings.Join(groups, thousandSep)
+	if fracPart != "" {
+		result += decimalSep + fracPart
+	}
+	return result
+}
+
+// separatorNames names the common number separators in scalar names.
+var separatorNames = map[string]string{
+	"":       "None",
+	".":      "Dot",
+	",":      "Comma",
+	" ":      "Space",
+	"'":      "Apostrophe",
+	"_":      "Underscore",
+	"\u00a0": "NoBreakSpace",
+	"\u2019": "RightQuote",
+	"\u202f": "NarrowNoBreakSpace",
+}
+
+// separatorName names sep for use in a scalar name, spelling out the code
+// points of separators that have no common name.
+func separatorName(sep string) string {
+	if name, ok := separatorNames[sep]; ok {
+		return name
+	}
+	name := ""
+	for _, r := range sep {
+		name += fmt.Sprintf("U%04X", r)
+	}
+	return name
+}
+
+// NewLocaleNumberScalar creates a scalar for numbers written with the given
+// decimal and thousands separators, such as `1,234.56` or `1.234,56`. Parsed
+// values are float64; serialized values use the same separators. The scalar
+// is named after its separators, decimal first, such as `LocaleNumberDotComma`
+// for `1,234.56`.
+func NewLocaleNumberScalar(decimalSep, thousandSep string) *Scalar {
+	if decimalSep == "" || decimalSep == thousandSep {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`Number separators %q and %q are invalid.`, decimalSep, thousandSep)),
+		}
+	}
+	intPattern := `\d+`
+	if thousandSep != "" {
+		intPattern = `(\d{1,3}(` + regexp.QuoteMeta(thousandSep) + `\d{3})+|\d+)`
+	}
+	numberRegexp := regexp.MustCompile(`^-?` + intPattern + `(` + regexp.QuoteMeta(decimalSep) + `\d+)?$`)
+
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if !numberRegexp.MatchString(value) {
+				return nil
+			}
+			if thousandSep != "" {
+				value = strings.Replace(value, thousandSep, "", -1)
+			}
+			value = strings.Replace(value, decimalSep, ".", 1)
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil
+			}
+			return f
+		case *string:
+			return parse(*value)
+		}
+		return nil
+	}
+	serialize := func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			value = parse(s)
+		}
+		f, err := CoerceFloatE(value)
+		if err != nil {
+			return nil
+		}
+		return formatLocaleNumber(f, decimalSep, thousandSep)
+	}
+	name := "LocaleNumber" + separatorName(decimalSep) + separatorName(thousandSep)
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents a number "+
+			"written with %q as decimal separator and %q as thousands separator.",
+			name, decimalSep, thousandSep),
+		Serialize:  serialize,
+		ParseValue: parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_LocaleNumber_ParsesUSAndEuropeanFormats(t *testing.T) {
+	us := graphql.NewLocaleNumberScalar(".", ",")
+	european := graphql.NewLocaleNumberScalar(",", ".")
+	if result := us.ParseLiteral(&ast.StringValue{Value: "1,234.56"}); result != 1234.56 {
+		t.Fatalf("Expected 1234.56, got: %v", result)
+	}
+	if result := european.ParseLiteral(&ast.StringValue{Value: "1.234,56"}); result != 1234.56 {
+		t.Fatalf("Expected 1234.56, got: %v", result)
+	}
+	if result := european.Serialize(1234567.5); result != "1.234.567,5" {
+		t.Fatalf("Expected 1.234.567,5, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_LocaleNumber_RejectsMalformedNumbers(t *testing.T) {
+	us := graphql.NewLocaleNumberScalar(".", ",")
+	for _, value := range []string{"1.234,56", "12,34.5", "1,234.", "abc"} {
+		if result := us.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_LocaleNumber_NamedAfterSeparators(t *testing.T) {
+	us := graphql.NewLocaleNumberScalar(".", ",")
+	european := graphql.NewLocaleNumberScalar(",", ".")
+	if us.Name() != "LocaleNumberDotComma" || european.Name() != "LocaleNumberCommaDot" {
+		t.Fatalf("Expected LocaleNumberDotComma and LocaleNumberCommaDot, got: %v and %v", us.Name(), european.Name())
+	}
+	if name := graphql.NewLocaleNumberScalar(",", "\u066c").Name(); name != "LocaleNumberCommaU066C" {
+		t.Fatalf("Expected LocaleNumberCommaU066C, got: %v", name)
+	}
+	assertScalarsCoexist(t, us, european)
+}
@@ -0,0 +1,18 @@
+package graphql
+
+// NewLocalizedBooleanScalar creates a scalar that maps words of any
+// language, such as "oui"/"non" or "ja"/"nein", to true or false regardless
+// of case. Unknown words are rejected. The scalar is named after its words,
+// such as `LocalizedBooleanOuiOrNon`.
+func NewLocalizedBooleanScalar(trueWords, falseWords []string) *Scalar {
+	mapped := NewMappedBooleanScalar(trueWords, falseWords, true)
+	name := "LocalizedBoolean" + typeNameFragment(trueWords...) + "Or" + typeNameFragment(falseWords...)
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents `true` or `false`, " +
+			"also accepting localized words for yes and no.",
+		Serialize:    mapped.Serialize,
+		ParseValue:   mapped.ParseValue,
+		ParseLiteral: mapped.ParseLiteral,
+	})
+}
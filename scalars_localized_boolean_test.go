@@ -0,0 +1,33 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_LocalizedBoolean_MapsWords(t *testing.T) {
+	french := graphql.NewLocalizedBooleanScalar([]string{"oui", "vrai"}, []string{"non", "faux"})
+	if result := french.ParseLiteral(&ast.StringValue{Value: "Oui"}); result != true {
+		t.Fatalf("Expected true, got: %v", result)
+	}
+	if result := french.ParseValue("NON"); result != false {
+		t.Fatalf("Expected false, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_LocalizedBoolean_RejectsUnknownWords(t *testing.T) {
+	french := graphql.NewLocalizedBooleanScalar([]string{"oui"}, []string{"non"})
+	if result := french.ParseValue("ja"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_LocalizedBoolean_NamedAfterWords(t *testing.T) {
+	french := graphql.NewLocalizedBooleanScalar([]string{"oui"}, []string{"non"})
+	if french.Name() != "LocalizedBooleanOuiOrNon" {
+		t.Fatalf("Expected LocalizedBooleanOuiOrNon, got: %v", french.Name())
+	}
+	assertScalarsCoexist(t, french, graphql.NewLocalizedBooleanScalar([]string{"ja"}, []string{"nein"}))
+}
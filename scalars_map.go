@@ -0,0 +1,34 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// MapScalar wraps s so that transform is applied to every value s parses,
+// and to every value before s serializes it, such as to title-case strings.
+// transform is not called with null values, so it never sees a value s
+// rejected.
+//
+// The wrapper is named after s and replaces it in a schema.
+func MapScalar(s *Scalar, transform func(interface{}) interface{}) *Scalar {
+	apply := func(value interface{}) interface{} {
+		if isNullish(value) {
+			return nil
+		}
+		return transform(value)
+	}
+	return NewScalar(ScalarConfig{
+		Name:        s.Name(),
+		Description: s.Description(),
+		Serialize: func(value interface{}) interface{} {
+			return s.Serialize(apply(value))
+		},
+		ParseValue: func(value interface{}) interface{} {
+			return apply(s.ParseValue(value))
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			return apply(s.ParseLiteral(valueAST))
+		},
+		RedactInErrors: s.scalarConfig.RedactInErrors,
+	})
+}
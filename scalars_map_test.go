@@ -0,0 +1,38 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_MapScalar_TransformsParsedAndSerializedValues(t *testing.T) {
+	upper := graphql.MapScalar(graphql.String, func(value interface{}) interface{} {
+		return strings.ToUpper(value.(string))
+	})
+	if result := upper.ParseLiteral(&ast.StringValue{Value: "hello"}); result != "HELLO" {
+		t.Fatalf("Expected HELLO, got: %v", result)
+	}
+	if result := upper.ParseValue("hello"); result != "HELLO" {
+		t.Fatalf("Expected HELLO, got: %v", result)
+	}
+	if result := upper.Serialize("hello"); result != "HELLO" {
+		t.Fatalf("Expected HELLO, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_MapScalar_SkipsTransformForNull(t *testing.T) {
+	called := false
+	mapped := graphql.MapScalar(graphql.String, func(value interface{}) interface{} {
+		called = true
+		return value
+	})
+	if result := mapped.ParseLiteral(&ast.IntValue{Value: "1"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if called {
+		t.Fatalf("Expected transform not to run for a rejected value")
+	}
+}
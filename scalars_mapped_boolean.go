@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewMappedBooleanScalar creates a scalar that maps the given strings to
+// true or false, such as "Y"/"N" or "1"/"0" found in CSV exports. Strings
+// that are in neither list are rejected, as are values that are neither
+// strings nor booleans. The scalar is named after its strings, such as
+// `MappedBooleanYOrN`, with a `CaseInsensitive` suffix when caseInsensitive
+// is set.
+func NewMappedBooleanScalar(trueValues, falseValues []string, caseInsensitive bool) *Scalar {
+	name := "MappedBoolean" + typeNameFragment(trueValues...) + "Or" + typeNameFragment(falseValues...)
+	if caseInsensitive {
+		name += "CaseInsensitive"
+	}
+	key := func(s string) string {
+		if caseInsensitive {
+			return strings.ToLower(s)
+		}
+		return s
+	}
+	mapping := map[string]bool{}
+	for _, v := range falseValues {
+		mapping[key(v)] = false
+	}
+	for _, v := range trueValues {
+		mapping[key(v)] = true
+	}
+
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case bool:
+			return value
+		case *bool:
+			return *value
+		case string:
+			if b, ok := mapping[key(value)]; ok {
+				return b
+			}
+		case *string:
+			return coerce(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents `true` or `false`, " +
+			"accepting a fixed set of strings for each.",
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.BooleanValue:
+				return valueAST.Value
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
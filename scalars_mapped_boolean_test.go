@@ -0,0 +1,47 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_MappedBoolean_MapsConfiguredStrings(t *testing.T) {
+	mapped := graphql.NewMappedBooleanScalar([]string{"Y", "yes", "1"}, []string{"N", "no", "0"}, false)
+	if result := mapped.ParseValue("Y"); result != true {
+		t.Fatalf("Expected true, got: %v", result)
+	}
+	if result := mapped.ParseLiteral(&ast.StringValue{Value: "N"}); result != false {
+		t.Fatalf("Expected false, got: %v", result)
+	}
+	if result := mapped.ParseValue("maybe"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := mapped.ParseValue("y"); result != nil {
+		t.Fatalf("Expected nil for case-sensitive mismatch, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_MappedBoolean_CaseInsensitive(t *testing.T) {
+	mapped := graphql.NewMappedBooleanScalar([]string{"Yes"}, []string{"No"}, true)
+	if result := mapped.ParseValue("YES"); result != true {
+		t.Fatalf("Expected true, got: %v", result)
+	}
+	if result := mapped.ParseValue("no"); result != false {
+		t.Fatalf("Expected false, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_MappedBoolean_NamedAfterStrings(t *testing.T) {
+	yesNo := graphql.NewMappedBooleanScalar([]string{"Y"}, []string{"N"}, false)
+	if yesNo.Name() != "MappedBooleanYOrN" {
+		t.Fatalf("Expected MappedBooleanYOrN, got: %v", yesNo.Name())
+	}
+	assertScalarsCoexist(t,
+		yesNo,
+		graphql.NewMappedBooleanScalar([]string{"Y"}, []string{"N"}, true),
+		graphql.NewMappedBooleanScalar([]string{"1"}, []string{"0"}, false),
+		graphql.NewMappedBooleanScalar([]string{"s\u00ed"}, []string{"no"}, true),
+	)
+}
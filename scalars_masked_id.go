@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var maskedIDRegexp = regexp.MustCompile(`^[0-9*]{3}-[0-9*]{2}-[0-9*]{4}$`)
+
+func coerceMaskedID(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !maskedIDRegexp.MatchString(value) || !strings.Contains(value, "*") {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceMaskedID(*value)
+	}
+	return nil
+}
+
+// MaskedID is the GraphQL partially masked identifier type definition.
+var MaskedID = NewScalar(ScalarConfig{
+	Name: "MaskedID",
+	Description: "The `MaskedID` scalar type represents a partially masked " +
+		"identifier grouped as `NNN-NN-NNNN`, where each position is a digit " +
+		"or `*` and at least one position is masked.",
+	Serialize:  coerceMaskedID,
+	ParseValue: coerceMaskedID,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceMaskedID(valueAST.Value)
+		}
+		return nil
+	},
+	RedactInErrors: true,
+})
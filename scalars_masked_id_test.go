@@ -0,0 +1,26 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_MaskedID_AcceptsMaskedForm(t *testing.T) {
+	if result := graphql.MaskedID.ParseLiteral(&ast.StringValue{Value: "***-**-1234"}); result != "***-**-1234" {
+		t.Fatalf("Expected ***-**-1234, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_MaskedID_RejectsInvalidGrouping(t *testing.T) {
+	for _, value := range []string{"****-*-1234", "***-**-12a4", "123-45-6789"} {
+		if result := graphql.MaskedID.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_MaskedID_RedactsValueInErrors(t *testing.T) {
+	assertRedactedInErrors(t, graphql.MaskedID, "123-45-6789")
+}
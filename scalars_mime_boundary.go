@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"regexp"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// mimeBoundaryRegexp matches the boundary syntax of RFC 2046: 1 to 70
+// characters from a restricted set, which may include spaces but not end
+// with one.
+var mimeBoundaryRegexp = regexp.MustCompile(`^[0-9A-Za-z'()+_,\-./:=? ]{0,69}[0-9A-Za-z'()+_,\-./:=?]$`)
+
+func coerceMimeBoundary(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !mimeBoundaryRegexp.MatchString(value) {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceMimeBoundary(*value)
+	}
+	return nil
+}
+
+// MimeBoundary is the GraphQL multipart boundary type definition.
+var MimeBoundary = NewScalar(ScalarConfig{
+	Name: "MimeBoundary",
+	Description: "The `MimeBoundary` scalar type represents a multipart boundary as " +
+		"specified by [RFC 2046](https://tools.ietf.org/html/rfc2046#section-5.1.1).",
+	Serialize:  coerceMimeBoundary,
+	ParseValue: coerceMimeBoundary,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceMimeBoundary(valueAST.Value)
+		}
+		return nil
+	},
+})
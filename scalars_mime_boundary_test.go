@@ -0,0 +1,25 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_MimeBoundary_AcceptsValidBoundaries(t *testing.T) {
+	for _, value := range []string{"----WebKitFormBoundary7MA4YWxkTrZu0gW", "simple boundary", strings.Repeat("a", 70)} {
+		if result := graphql.MimeBoundary.ParseLiteral(&ast.StringValue{Value: value}); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_MimeBoundary_RejectsInvalidBoundaries(t *testing.T) {
+	for _, value := range []string{"bad;boundary", "trailing ", "", strings.Repeat("a", 71)} {
+		if result := graphql.MimeBoundary.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
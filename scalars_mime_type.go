@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// coerceMimeType returns the lowercased `type/subtype` of a media type,
+// dropping any parameters.
+func coerceMimeType(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		mediaType, _, err := mime.ParseMediaType(value)
+		if err != nil {
+			return nil
+		}
+		slash := strings.Index(mediaType, "/")
+		if slash <= 0 || slash == len(mediaType)-1 {
+			return nil
+		}
+		return mediaType
+	case *string:
+		return coerceMimeType(*value)
+	}
+	return nil
+}
+
+// MimeType is the GraphQL MIME content type definition.
+var MimeType = NewScalar(ScalarConfig{
+	Name: "MimeType",
+	Description: "The `MimeType` scalar type represents a media type such as " +
+		"`text/html`. Parameters are accepted on input but dropped.",
+	Serialize:  coerceMimeType,
+	ParseValue: coerceMimeType,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceMimeType(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,25 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_MimeType_DropsParameters(t *testing.T) {
+	if result := graphql.MimeType.ParseValue("text/html; charset=utf-8"); result != "text/html" {
+		t.Fatalf("Expected text/html, got: %v", result)
+	}
+	if result := graphql.MimeType.ParseLiteral(&ast.StringValue{Value: "Application/JSON"}); result != "application/json" {
+		t.Fatalf("Expected application/json, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_MimeType_RejectsInvalidMediaType(t *testing.T) {
+	for _, value := range []string{"notamime", "text/", "/html", "text/html; charset"} {
+		if result := graphql.MimeType.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceMinorUnits(value interface{}) interface{} {
+	var amount int64
+	switch value := value.(type) {
+	case int:
+		amount = int64(value)
+	case *int:
+		return coerceMinorUnits(*value)
+	case int32:
+		amount = int64(value)
+	case int64:
+		amount = value
+	case *int64:
+		return coerceMinorUnits(*value)
+	case uint64:
+		if value > math.MaxInt64 {
+			return nil
+		}
+		amount = int64(value)
+	case float64:
+		// 2^63 is the first float64 above the int64 range.
+		if value != math.Trunc(value) || value >= math.MaxInt64 {
+			return nil
+		}
+		amount = int64(value)
+	case *float64:
+		return coerceMinorUnits(*value)
+	case string:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil
+		}
+		amount = i
+	case *string:
+		return coerceMinorUnits(*value)
+	default:
+		return nil
+	}
+	if amount <= 0 {
+		return nil
+	}
+	return amount
+}
+
+// MinorUnits is the GraphQL positive monetary amount type definition. Values
+// are int64 amounts in the minor unit of a currency, such as cents.
+var MinorUnits = NewScalar(ScalarConfig{
+	Name: "MinorUnits",
+	Description: "The `MinorUnits` scalar type represents a strictly positive " +
+		"monetary amount in the minor unit of its currency, such as cents, up " +
+		"to 2^63 - 1.",
+	Serialize:  coerceMinorUnits,
+	ParseValue: coerceMinorUnits,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.IntValue:
+			return coerceMinorUnits(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,28 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_MinorUnits_AcceptsPositiveAmounts(t *testing.T) {
+	if result := graphql.MinorUnits.ParseLiteral(&ast.IntValue{Value: "100"}); result != int64(100) {
+		t.Fatalf("Expected 100, got: %v", result)
+	}
+	if result := graphql.MinorUnits.ParseValue(float64(100)); result != int64(100) {
+		t.Fatalf("Expected 100, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_MinorUnits_RejectsNonPositiveAndOverflow(t *testing.T) {
+	for _, value := range []string{"0", "-100", "9223372036854775808"} {
+		if result := graphql.MinorUnits.ParseLiteral(&ast.IntValue{Value: value}); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+	if result := graphql.MinorUnits.ParseValue(1e19); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
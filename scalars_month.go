@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// parseMonth maps a month number from 1 to 12, or a full or three-letter
+// month name in any case, to its time.Month.
+func parseMonth(value interface{}) (time.Month, bool) {
+	switch value := value.(type) {
+	case time.Month:
+		return value, value >= time.January && value <= time.December
+	case *time.Month:
+		return parseMonth(*value)
+	case string:
+		name := strings.ToLower(value)
+		for m := time.January; m <= time.December; m++ {
+			full := strings.ToLower(m.String())
+			if name == full || name == full[:3] {
+				return m, true
+			}
+		}
+		return 0, false
+	case *string:
+		return parseMonth(*value)
+	case bool, *bool:
+		return 0, false
+	}
+	if n, ok := coerceInt(value).(int); ok {
+		return parseMonth(time.Month(n))
+	}
+	return 0, false
+}
+
+func serializeMonth(value interface{}) interface{} {
+	if m, ok := parseMonth(value); ok {
+		return m.String()
+	}
+	return nil
+}
+
+func unserializeMonth(value interface{}) interface{} {
+	if m, ok := parseMonth(value); ok {
+		return m
+	}
+	return nil
+}
+
+// Month is the GraphQL month of the year type definition.
+var Month = NewScalar(ScalarConfig{
+	Name: "Month",
+	Description: "The `Month` scalar type represents a month of the year. Input " +
+		"accepts a number from 1 to 12 or a full or three-letter name in any " +
+		"case, such as `jan` or `January`; output is the full name.",
+	Serialize:  serializeMonth,
+	ParseValue: unserializeMonth,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeMonth(valueAST.Value)
+		case *ast.IntValue:
+			if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
+				return unserializeMonth(intValue)
+			}
+		}
+		return nil
+	},
+})
@@ -0,0 +1,47 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Month_ParsesNames(t *testing.T) {
+	for value, expected := range map[string]time.Month{
+		"feb":     time.February,
+		"January": time.January,
+		"DEC":     time.December,
+	} {
+		if result := graphql.Month.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Month_ParsesNumbers(t *testing.T) {
+	if result := graphql.Month.ParseLiteral(&ast.IntValue{Value: "3"}); result != time.March {
+		t.Fatalf("Expected %v, got: %v", time.March, result)
+	}
+	if result := graphql.Month.ParseValue(3); result != time.March {
+		t.Fatalf("Expected %v, got: %v", time.March, result)
+	}
+}
+
+func TestTypeSystem_Scalar_Month_RejectsInvalidMonths(t *testing.T) {
+	for _, value := range []interface{}{13, 0, "febr", true} {
+		if result := graphql.Month.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+	if result := graphql.Month.ParseLiteral(&ast.IntValue{Value: "13"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Month_SerializesFullName(t *testing.T) {
+	if result := graphql.Month.Serialize(time.February); result != "February" {
+		t.Fatalf("Expected %v, got: %v", "February", result)
+	}
+}
@@ -0,0 +1,114 @@
+package graphql
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// multihashDigestLengths holds the digest length of common multihash
+// function codes.
+var multihashDigestLengths = map[uint64]uint64{
+	0x11:   20, // sha1
+	0x12:   32, // sha2-256
+	0x13:   64, // sha2-512
+	0x16:   32, // sha3-256
+	0x14:   64, // sha3-512
+	0xb220: 32, // blake2b-256
+}
+
+func encodeBase58(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	encoded := []byte{}
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	// Each leading zero byte is encoded as a leading `1`.
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+func decodeBase58(s string) ([]byte, bool) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, r := range s {
+		i := strings.IndexRune(base58Alphabet, r)
+		if i < 0 {
+			return nil, false
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(i)))
+	}
+	zeros := len(s) - len(strings.TrimLeft(s, base58Alphabet[:1]))
+	return append(make([]byte, zeros), n.Bytes()...), true
+}
+
+// isMultihash reports whether b is a varint function code followed by a
+// varint digest length and a digest of exactly that length, which must also
+// match the function's digest length when it is known.
+func isMultihash(b []byte) bool {
+	code, n := binary.Uvarint(b)
+	if n <= 0 {
+		return false
+	}
+	length, m := binary.Uvarint(b[n:])
+	if m <= 0 || uint64(len(b)-n-m) != length || length == 0 {
+		return false
+	}
+	if expected, ok := multihashDigestLengths[code]; ok && length != expected {
+		return false
+	}
+	return true
+}
+
+func coerceMultihash(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if b, err := hex.DecodeString(value); err == nil && isMultihash(b) {
+			return encodeBase58(b)
+		}
+		if b, ok := decodeBase58(value); ok && isMultihash(b) {
+			return encodeBase58(b)
+		}
+	case *string:
+		return coerceMultihash(*value)
+	case []byte:
+		if isMultihash(value) {
+			return encodeBase58(value)
+		}
+	}
+	return nil
+}
+
+// Multihash is the GraphQL self-describing hash type definition.
+var Multihash = NewScalar(ScalarConfig{
+	Name: "Multihash",
+	Description: "The `Multihash` scalar type represents a self-describing " +
+		"[multihash](https://multiformats.io/multihash/), given in hex or " +
+		"base58btc and normalized to base58btc.",
+	Serialize:  coerceMultihash,
+	ParseValue: coerceMultihash,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceMultihash(valueAST.Value)
+		}
+		return nil
+	},
+})
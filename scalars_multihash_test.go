@@ -0,0 +1,34 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	helloWorldMultihash    = "QmaozNR7DZHQK1ZcU9p7QdrshMvXqWK6gpu5rmrkPdT3L4"
+	helloWorldMultihashHex = "1220b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+)
+
+func TestTypeSystem_Scalar_Multihash_AcceptsSHA256Multihash(t *testing.T) {
+	for _, value := range []string{helloWorldMultihash, helloWorldMultihashHex} {
+		if result := graphql.Multihash.ParseLiteral(&ast.StringValue{Value: value}); result != helloWorldMultihash {
+			t.Fatalf("Expected %v for %q, got: %v", helloWorldMultihash, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Multihash_RejectsTruncatedMultihash(t *testing.T) {
+	for _, value := range []string{
+		helloWorldMultihashHex[:len(helloWorldMultihashHex)-2],
+		helloWorldMultihash[:len(helloWorldMultihash)-1],
+		"1214b94d27b9934d3e08a52e52d7da7dabfac484",
+		"0OIl",
+	} {
+		if result := graphql.Multihash.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
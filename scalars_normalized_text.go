@@ -0,0 +1,35 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// parseNormalizedText trims value and collapses each run of whitespace in it
+// to a single space.
+func parseNormalizedText(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		return strings.Join(strings.Fields(value), " ")
+	case *string:
+		return parseNormalizedText(*value)
+	}
+	return nil
+}
+
+// NormalizedText is the GraphQL whitespace-normalized text type definition.
+var NormalizedText = NewScalar(ScalarConfig{
+	Name: "NormalizedText",
+	Description: "The `NormalizedText` scalar type represents textual data whose " +
+		"whitespace is trimmed and collapsed to single spaces on input.",
+	Serialize:  coerceString,
+	ParseValue: parseNormalizedText,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return parseNormalizedText(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,23 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_NormalizedText_CollapsesWhitespace(t *testing.T) {
+	if result := graphql.NormalizedText.ParseValue("  a\t\n b   c "); result != "a b c" {
+		t.Fatalf("Expected %q, got: %q", "a b c", result)
+	}
+	if result := graphql.NormalizedText.ParseLiteral(&ast.StringValue{Value: "x\r\ny"}); result != "x y" {
+		t.Fatalf("Expected %q, got: %q", "x y", result)
+	}
+}
+
+func TestTypeSystem_Scalar_NormalizedText_SerializePassesThrough(t *testing.T) {
+	if result := graphql.NormalizedText.Serialize("  a  b "); result != "  a  b " {
+		t.Fatalf("Expected %q, got: %q", "  a  b ", result)
+	}
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+// NullableScalar wraps s so that input values s cannot parse are treated as
+// null instead of being reported as invalid: arguments and variables holding
+// such a value are left out, and list elements become null. Values s cannot
+// serialize are resolved to null. In a non-null position, where a value
+// cannot become null, unparsable values are still reported as invalid.
+//
+// This is the opposite of NonNullScalar; wrapping a NonNullScalar restores
+// the behaviour of the scalar it wraps, and vice versa. The wrapper is named
+// after s and replaces it in a schema.
+func NullableScalar(s *Scalar) *Scalar {
+	if s.wrapped != nil {
+		s = s.wrapped
+	}
+	scalar := NewScalar(ScalarConfig{
+		Name:           s.Name(),
+		Description:    s.Description(),
+		Serialize:      s.Serialize,
+		ParseValue:     s.ParseValue,
+		ParseLiteral:   s.ParseLiteral,
+		RedactInErrors: s.scalarConfig.RedactInErrors,
+	})
+	scalar.wrapped = s
+	scalar.nullOnInvalid = true
+	return scalar
+}
+
+// NonNullScalar wraps s so that a non-null value which s cannot serialize
+// raises a field error, instead of being silently resolved to null. Input
+// values s cannot parse are reported as invalid, even when s was created by
+// NullableScalar.
+//
+// The wrapper is named after s and replaces it in a schema.
+func NonNullScalar(s *Scalar) *Scalar {
+	if s.wrapped != nil {
+		s = s.wrapped
+	}
+	scalar := NewScalar(ScalarConfig{
+		Name:        s.Name(),
+		Description: s.Description(),
+		Serialize: func(value interface{}) interface{} {
+			serialized := s.Serialize(value)
+			if isNullish(serialized) && !isNullish(value) {
+				if s.scalarConfig.RedactInErrors {
+					value = "<redacted>"
+				}
+				err := gqlerrors.NewFormattedError(fmt.Sprintf(`%v cannot represent value: %v`, s.Name(), value))
+				panic(err)
+			}
+			return serialized
+		},
+		ParseValue:     s.ParseValue,
+		ParseLiteral:   s.ParseLiteral,
+		RedactInErrors: s.scalarConfig.RedactInErrors,
+	})
+	scalar.wrapped = s
+	return scalar
+}
@@ -0,0 +1,178 @@
+package graphql_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestTypeSystem_Scalar_NullableScalar_TurnsStrictErrorIntoNull(t *testing.T) {
+	strictInt := graphql.NonNullScalar(graphql.Int)
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatalf("Expected strict Int to raise an error for a bad value")
+			}
+		}()
+		strictInt.Serialize("not an int")
+	}()
+
+	nullableInt := graphql.NullableScalar(strictInt)
+	if result := nullableInt.Serialize("not an int"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := nullableInt.Serialize(42); result != 42 {
+		t.Fatalf("Expected 42, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_NonNullScalar_ReportsFieldError(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"count": &graphql.Field{
+					Type: graphql.NonNullScalar(graphql.Int),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "many", nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"count": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "Int cannot represent value: many",
+				Locations: []location.SourceLocation{},
+			},
+		},
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ count }`,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func nullableScalarTestSchema(t *testing.T, argType graphql.Input) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"echo": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: argType},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return fmt.Sprintf("%v", p.Args["n"]), nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	return schema
+}
+
+func TestTypeSystem_Scalar_NullableScalar_ParsesBadValuesAsNull(t *testing.T) {
+	nullableInt := graphql.NullableScalar(graphql.NonNullScalar(graphql.Int))
+	schema := nullableScalarTestSchema(t, graphql.NewList(nullableInt))
+
+	tests := []struct {
+		query     string
+		variables map[string]interface{}
+		expected  string
+	}{
+		{`{ echo(n: "many") }`, nil, "[<nil>]"},
+		{`{ echo(n: [1, "many", 3]) }`, nil, "[1 <nil> 3]"},
+		{`query ($n: [Int]) { echo(n: $n) }`, map[string]interface{}{"n": "many"}, "[<nil>]"},
+		{`query ($n: [Int]) { echo(n: $n) }`, map[string]interface{}{"n": []interface{}{1, "many", 3}}, "[1 <nil> 3]"},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  test.query,
+			VariableValues: test.variables,
+		})
+		if len(result.Errors) > 0 {
+			t.Fatalf("wrong result for %v, unexpected errors: %v", test.query, result.Errors)
+		}
+		expected := map[string]interface{}{"echo": test.expected}
+		if !reflect.DeepEqual(expected, result.Data) {
+			t.Fatalf("Expected %v for %v, got: %v", expected, test.query, result.Data)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_NullableScalar_RejectsBadValuesWhereNullIsNotAllowed(t *testing.T) {
+	nullableInt := graphql.NullableScalar(graphql.Int)
+	schema := nullableScalarTestSchema(t, graphql.NewNonNull(nullableInt))
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ echo(n: "many") }`,
+	})
+	expected := "Argument \"n\" has invalid value \"many\".\nExpected type \"Int\", found \"many\"."
+	if len(result.Errors) != 1 || result.Errors[0].Message != expected {
+		t.Fatalf("Expected error %q, got: %v", expected, result.Errors)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($n: Int!) { echo(n: $n) }`,
+		VariableValues: map[string]interface{}{"n": "many"},
+	})
+	expected = "Variable \"$n\" got invalid value \"many\".\nExpected type \"Int\", found \"many\"."
+	if len(result.Errors) != 1 || result.Errors[0].Message != expected {
+		t.Fatalf("Expected error %q, got: %v", expected, result.Errors)
+	}
+}
+
+func TestTypeSystem_Scalar_NonNullScalar_ReportsBadValuesOfANullableScalar(t *testing.T) {
+	strictInt := graphql.NonNullScalar(graphql.NullableScalar(graphql.Int))
+	schema := nullableScalarTestSchema(t, strictInt)
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ echo(n: "many") }`,
+	})
+	expected := "Argument \"n\" has invalid value \"many\".\nExpected type \"Int\", found \"many\"."
+	if len(result.Errors) != 1 || result.Errors[0].Message != expected {
+		t.Fatalf("Expected error %q, got: %v", expected, result.Errors)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($n: Int) { echo(n: $n) }`,
+		VariableValues: map[string]interface{}{"n": "many"},
+	})
+	expected = "Variable \"$n\" got invalid value \"many\".\nExpected type \"Int\", found \"many\"."
+	if len(result.Errors) != 1 || result.Errors[0].Message != expected {
+		t.Fatalf("Expected error %q, got: %v", expected, result.Errors)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ echo(n: 3) }`,
+	})
+	if len(result.Errors) > 0 || !reflect.DeepEqual(map[string]interface{}{"echo": "3"}, result.Data) {
+		t.Fatalf("Unexpected result: %v", result)
+	}
+}
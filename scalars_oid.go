@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var oidArcRegexp = regexp.MustCompile(`^(0|[1-9]\d*)$`)
+
+// validOID reports whether s is a dotted object identifier: at least two
+// non-negative integer arcs without leading zeros, the first of which is
+// 0, 1 or 2. Under the 0 and 1 roots the second arc must be below 40.
+func validOID(s string) bool {
+	arcs := strings.Split(s, ".")
+	if len(arcs) < 2 {
+		return false
+	}
+	for _, arc := range arcs {
+		if !oidArcRegexp.MatchString(arc) {
+			return false
+		}
+	}
+	first, _ := strconv.Atoi(arcs[0])
+	if first > 2 {
+		return false
+	}
+	if first < 2 {
+		second, err := strconv.Atoi(arcs[1])
+		if err != nil || second >= 40 {
+			return false
+		}
+	}
+	return true
+}
+
+func coerceOID(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !validOID(value) {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceOID(*value)
+	}
+	return nil
+}
+
+// OID is the GraphQL object identifier type definition.
+var OID = NewScalar(ScalarConfig{
+	Name: "OID",
+	Description: "The `OID` scalar type represents an ASN.1 object identifier in " +
+		"dotted notation, such as `1.3.6.1.4.1`.",
+	Serialize:  coerceOID,
+	ParseValue: coerceOID,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceOID(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,27 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_OID_AcceptsValidOID(t *testing.T) {
+	for _, value := range []string{"1.3.6.1.4.1", "2.5.4.3", "0.9"} {
+		if result := graphql.OID.ParseValue(value); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.OID.ParseLiteral(&ast.StringValue{Value: "2.999.1"}); result != "2.999.1" {
+		t.Fatalf("Expected 2.999.1, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_OID_RejectsInvalidOID(t *testing.T) {
+	for _, value := range []string{"1.3.x.1", "1", "3.1", "1.40", "1..3", "1.03"} {
+		if result := graphql.OID.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"math"
+)
+
+// NewOpacityScalar creates an `Opacity` scalar for opacities between 0 and
+// 1. Values outside that range are clamped to it when clamp is set, in which
+// case the scalar is named `ClampedOpacity`, and rejected otherwise.
+func NewOpacityScalar(clamp bool) *Scalar {
+	name := "Opacity"
+	if clamp {
+		name = "ClampedOpacity"
+	}
+	coerce := func(value interface{}) interface{} {
+		f, err := CoerceFloatE(value)
+		if err != nil || math.IsNaN(f) {
+			return nil
+		}
+		if f < 0 || f > 1 {
+			if !clamp {
+				return nil
+			}
+			f = math.Max(0, math.Min(1, f))
+		}
+		return f
+	}
+	description := "The `" + name + "` scalar type represents an opacity " +
+		"between 0 (transparent) and 1 (opaque)."
+	if clamp {
+		description += " Values outside that range are clamped to it."
+	}
+	return NewScalar(ScalarConfig{
+		Name:         name,
+		Description:  description,
+		Serialize:    coerce,
+		ParseValue:   coerce,
+		ParseLiteral: parseLiteralFloat(coerce),
+	})
+}
+
+// Opacity is the GraphQL opacity type definition, rejecting values outside
+// [0, 1].
+var Opacity = NewOpacityScalar(false)
@@ -0,0 +1,35 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Opacity_RejectsOutOfRangeByDefault(t *testing.T) {
+	if result := graphql.Opacity.ParseLiteral(&ast.FloatValue{Value: "0.5"}); result != 0.5 {
+		t.Fatalf("Expected 0.5, got: %v", result)
+	}
+	if result := graphql.Opacity.ParseLiteral(&ast.FloatValue{Value: "1.5"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Opacity_ClampsWhenConfigured(t *testing.T) {
+	clamped := graphql.NewOpacityScalar(true)
+	if result := clamped.ParseLiteral(&ast.FloatValue{Value: "1.5"}); result != 1.0 {
+		t.Fatalf("Expected 1.0, got: %v", result)
+	}
+	if result := clamped.ParseValue(-2); result != 0.0 {
+		t.Fatalf("Expected 0.0, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Opacity_NamesClampingVariant(t *testing.T) {
+	clamped := graphql.NewOpacityScalar(true)
+	if clamped.Name() != "ClampedOpacity" {
+		t.Fatalf("Expected ClampedOpacity, got: %v", clamped.Name())
+	}
+	assertScalarsCoexist(t, graphql.Opacity, clamped)
+}
@@ -0,0 +1,100 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Optional distinguishes an input that was omitted from one that was
+// explicitly null. Set is false when the value was omitted, and Value is nil
+// when it was provided as null.
+type Optional struct {
+	Value interface{}
+	Set   bool
+}
+
+// OptionalArg returns the argument name of args as an Optional. Arguments
+// that are missing from args are reported as not set.
+func OptionalArg(args map[string]interface{}, name string) Optional {
+	value, ok := args[name]
+	if !ok {
+		return Optional{}
+	}
+	if value, ok := value.(Optional); ok {
+		return value
+	}
+	return Optional{Value: value, Set: true}
+}
+
+// newOptionalScalar creates a scalar that parses into an Optional using
+// parse and parseLiteral for non-null values, and serializes Optional
+// values, as well as plain values, using serialize. A variable of the scalar
+// type that is explicitly provided as null is parsed into an Optional that
+// is set but holds nil, while an omitted one leaves the argument out.
+func newOptionalScalar(name string, description string, serialize SerializeFn, parse ParseValueFn, parseLiteral ParseLiteralFn) *Scalar {
+	wrap := func(parsed interface{}) interface{} {
+		if parsed == nil {
+			return nil
+		}
+		return Optional{Value: parsed, Set: true}
+	}
+	scalar := NewScalar(ScalarConfig{
+		Name:        name,
+		Description: description,
+		Serialize: func(value interface{}) interface{} {
+			if value, ok := value.(Optional); ok {
+				if !value.Set || value.Value == nil {
+					return nil
+				}
+				return serialize(value.Value)
+			}
+			return serialize(value)
+		},
+		ParseValue: func(value interface{}) interface{} {
+			switch value := value.(type) {
+			case nil:
+				return Optional{Set: true}
+			case Optional:
+				return value
+			}
+			return wrap(parse(value))
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			return wrap(parseLiteral(valueAST))
+		},
+	})
+	scalar.parsesNull = true
+	return scalar
+}
+
+// NewOptionalIntScalar creates an `OptionalInt` scalar that behaves like Int
+// but parses into an Optional holding an int, so that resolvers can tell an
+// omitted argument apart from an explicit null.
+func NewOptionalIntScalar() *Scalar {
+	return newOptionalScalar(
+		"OptionalInt",
+		"The `OptionalInt` scalar type represents an Int that may be omitted or null.",
+		coerceInt,
+		coerceInt,
+		Int.ParseLiteral,
+	)
+}
+
+// NewOptionalFloatScalar creates an `OptionalFloat` scalar that behaves like
+// Float but parses into an Optional holding a float64, so that resolvers can
+// tell an omitted argument apart from an explicit null.
+func NewOptionalFloatScalar() *Scalar {
+	parse := func(value interface{}) interface{} {
+		f, err := CoerceFloatE(value)
+		if err != nil {
+			return nil
+		}
+		return f
+	}
+	return newOptionalScalar(
+		"OptionalFloat",
+		"The `OptionalFloat` scalar type represents a Float that may be omitted or null.",
+		coerceFloat,
+		parse,
+		Float.ParseLiteral,
+	)
+}
@@ -0,0 +1,123 @@
+package graphql_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Optional_DistinguishesNullFromOmitted(t *testing.T) {
+	optionalInt := graphql.NewOptionalIntScalar()
+	args := map[string]interface{}{
+		"null":  optionalInt.ParseValue(nil),
+		"value": optionalInt.ParseValue(3),
+	}
+
+	expected := graphql.Optional{Set: true}
+	if result := graphql.OptionalArg(args, "null"); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+	expected = graphql.Optional{Value: 3, Set: true}
+	if result := graphql.OptionalArg(args, "value"); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+	expected = graphql.Optional{}
+	if result := graphql.OptionalArg(args, "absent"); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_Optional_DistinguishesNullVariableFromOmitted(t *testing.T) {
+	optionalInt := graphql.NewOptionalIntScalar()
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"limit": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: optionalInt},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return fmt.Sprintf("%+v", graphql.OptionalArg(p.Args, "n")), nil
+					},
+				},
+				"count": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return fmt.Sprintf("%v", p.Args["n"]), nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	query := `query ($n: OptionalInt, $m: Int) {
+		variable: limit(n: $n)
+		omitted: limit
+		value: limit(n: 3)
+		plain: count(n: $m)
+	}`
+	tests := []struct {
+		variables map[string]interface{}
+		expected  map[string]interface{}
+	}{
+		{
+			variables: map[string]interface{}{"n": nil, "m": nil},
+			expected: map[string]interface{}{
+				"variable": "{Value:<nil> Set:true}",
+				"omitted":  "{Value:<nil> Set:false}",
+				"value":    "{Value:3 Set:true}",
+				"plain":    "10",
+			},
+		},
+		{
+			variables: map[string]interface{}{},
+			expected: map[string]interface{}{
+				"variable": "{Value:<nil> Set:false}",
+				"omitted":  "{Value:<nil> Set:false}",
+				"value":    "{Value:3 Set:true}",
+				"plain":    "10",
+			},
+		},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  query,
+			VariableValues: test.variables,
+		})
+		if len(result.Errors) > 0 {
+			t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+		}
+		if !reflect.DeepEqual(test.expected, result.Data) {
+			t.Fatalf("Expected %v, got: %v", test.expected, result.Data)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Optional_ParsesLiteralsAndSerializes(t *testing.T) {
+	optionalFloat := graphql.NewOptionalFloatScalar()
+	expected := graphql.Optional{Value: 1.5, Set: true}
+	if result := optionalFloat.ParseLiteral(&ast.FloatValue{Value: "1.5"}); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+	if result := optionalFloat.ParseValue("not a float"); result != nil {
+		t.Fatalf("Expected nil, got: %+v", result)
+	}
+	if result := optionalFloat.Serialize(expected); result != 1.5 {
+		t.Fatalf("Expected 1.5, got: %v", result)
+	}
+	if result := optionalFloat.Serialize(graphql.Optional{Set: true}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
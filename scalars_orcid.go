@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var orcidRegexp = regexp.MustCompile(`^\d{4}-\d{4}-\d{4}-\d{3}[\dX]$`)
+
+// orcidCheckDigit computes the ISO 7064 mod 11-2 check digit of the first
+// 15 digits of an ORCID.
+func orcidCheckDigit(digits string) byte {
+	total := 0
+	for _, r := range digits {
+		total = (total + int(r-'0')) * 2
+	}
+	result := (12 - total%11) % 11
+	if result == 10 {
+		return 'X'
+	}
+	return byte('0' + result)
+}
+
+func coerceORCID(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		orcid := strings.ToUpper(strings.TrimSpace(value))
+		if !orcidRegexp.MatchString(orcid) {
+			return nil
+		}
+		digits := strings.Replace(orcid, "-", "", -1)
+		if orcidCheckDigit(digits[:15]) != digits[15] {
+			return nil
+		}
+		return orcid
+	case *string:
+		return coerceORCID(*value)
+	}
+	return nil
+}
+
+// ORCID is the GraphQL researcher identifier type definition.
+var ORCID = NewScalar(ScalarConfig{
+	Name: "ORCID",
+	Description: "The `ORCID` scalar type represents an ORCID researcher identifier " +
+		"of the form `0000-0002-1825-0097`, with a valid check digit.",
+	Serialize:  coerceORCID,
+	ParseValue: coerceORCID,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceORCID(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,27 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_ORCID_AcceptsValidORCIDs(t *testing.T) {
+	for value, expected := range map[string]string{
+		"0000-0002-1825-0097": "0000-0002-1825-0097",
+		"0000-0002-1694-233x": "0000-0002-1694-233X",
+	} {
+		if result := graphql.ORCID.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_ORCID_RejectsInvalidORCIDs(t *testing.T) {
+	for _, value := range []string{"0000-0002-1825-0098", "0000000218250097", "0000-0002-1825-009"} {
+		if result := graphql.ORCID.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
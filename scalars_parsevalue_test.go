@@ -1,11 +1,14 @@
 package graphql_test
 
 import (
+	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
 )
 
 func TestTypeSystem_Scalar_ParseValueOutputDateTime(t *testing.T) {
@@ -24,3 +27,159 @@ func TestTypeSystem_Scalar_ParseValueOutputDateTime(t *testing.T) {
 		}
 	}
 }
+
+func TestTypeSystem_Scalar_CoerceObject(t *testing.T) {
+	fields := map[string]func(interface{}) interface{}{
+		"name": graphql.String.ParseValue,
+		"age":  graphql.Int.ParseValue,
+		"born": graphql.DateTime.ParseValue,
+	}
+	result, err := graphql.CoerceObject(fields, map[string]interface{}{
+		"name": "Luke",
+		"age":  "19",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{"name": "Luke", "age": 19}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_CoerceObjectNamesFailingField(t *testing.T) {
+	fields := map[string]func(interface{}) interface{}{
+		"name": graphql.String.ParseValue,
+		"born": graphql.DateTime.ParseValue,
+	}
+	_, err := graphql.CoerceObject(fields, map[string]interface{}{
+		"name": "Luke",
+		"born": "long ago",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), `"born"`) {
+		t.Fatalf("Expected error to name the born field, got: %v", err)
+	}
+}
+
+func TestTypeSystem_Scalar_ParseLiteralOutputDateTime(t *testing.T) {
+	if val := graphql.DateTime.ParseLiteral(&ast.StringValue{Value: "not-a-date"}); val != "not-a-date" {
+		t.Fatalf("Expected DateTime to pass literals through unparsed, got: %v", val)
+	}
+	t1, _ := time.Parse(time.RFC3339, "2017-07-23T03:46:56.647Z")
+	dateTimeUTC := graphql.NewDateTimeScalarLoc(time.UTC)
+	if val := dateTimeUTC.ParseLiteral(&ast.StringValue{Value: "2017-07-23T03:46:56.647Z"}); val != t1 {
+		t.Fatalf("Expected %v, got: %v", t1, val)
+	}
+	if val := dateTimeUTC.ParseLiteral(&ast.StringValue{Value: "not-a-date"}); val != nil {
+		t.Fatalf("Expected nil, got: %v", val)
+	}
+}
+
+func TestTypeSystem_Scalar_DateTimeLocParsesZonelessInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dateTime := graphql.NewDateTimeScalarLoc(loc)
+
+	expected := time.Date(2023, 6, 1, 16, 0, 0, 0, time.UTC)
+	val, ok := dateTime.ParseValue("2023-06-01T12:00:00").(time.Time)
+	if !ok || !val.Equal(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, val)
+	}
+	val, ok = dateTime.ParseLiteral(&ast.StringValue{Value: "2023-06-01T12:00:00Z"}).(time.Time)
+	if !ok || !val.Equal(expected.Add(-4*time.Hour)) {
+		t.Fatalf("Expected %v, got: %v", expected.Add(-4*time.Hour), val)
+	}
+	if val := dateTime.ParseValue("2023-06-01"); val != nil {
+		t.Fatalf("Expected nil, got: %v", val)
+	}
+}
+
+func TestTypeSystem_Scalar_DateTimeLocNamedAfterLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dateTime := graphql.NewDateTimeScalarLoc(loc)
+	if dateTime.Name() != "DateTimeAmericaNewYork" {
+		t.Fatalf("Expected DateTimeAmericaNewYork, got: %v", dateTime.Name())
+	}
+	assertScalarsCoexist(t, graphql.DateTime, dateTime, graphql.NewDateTimeScalarLoc(time.UTC))
+}
+
+func TestTypeSystem_Scalar_DateTimeLocRequiresLocation(t *testing.T) {
+	if graphql.NewDateTimeScalarLoc(nil).Error() == nil {
+		t.Fatalf("Expected an error for a nil location")
+	}
+}
+
+func TestTypeSystem_Scalar_MultiLayoutDateTimeTriesLayoutsInOrder(t *testing.T) {
+	dateTime := graphql.NewMultiLayoutDateTimeScalar([]string{
+		time.RFC3339,
+		"02/01/2006 15:04",
+		time.RFC1123,
+	})
+
+	expected := time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)
+	val, ok := dateTime.ParseLiteral(&ast.StringValue{Value: "01/06/2023 12:30"}).(time.Time)
+	if !ok || !val.Equal(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, val)
+	}
+	if serialized := dateTime.Serialize(expected); serialized != "2023-06-01T12:30:00Z" {
+		t.Fatalf("Expected 2023-06-01T12:30:00Z, got: %v", serialized)
+	}
+	if val := dateTime.ParseValue("June 1st"); val != nil {
+		t.Fatalf("Expected nil, got: %v", val)
+	}
+}
+
+func TestTypeSystem_Scalar_MultiLayoutDateTimeNamedAfterLayouts(t *testing.T) {
+	dateTime := graphql.NewMultiLayoutDateTimeScalar([]string{"2006-01-02", "02/01/2006"})
+	if dateTime.Name() != "DateTime20060102Or02012006" {
+		t.Fatalf("Expected DateTime20060102Or02012006, got: %v", dateTime.Name())
+	}
+	assertScalarsCoexist(t, graphql.DateTime, dateTime,
+		graphql.NewMultiLayoutDateTimeScalar([]string{time.Kitchen}))
+}
+
+func TestTypeSystem_Scalar_ParseLiteralBooleanFromIntAndString(t *testing.T) {
+	tests := []struct {
+		Value    ast.Value
+		Expected interface{}
+	}{
+		{&ast.BooleanValue{Value: true}, true},
+		{&ast.IntValue{Value: "1"}, true},
+		{&ast.IntValue{Value: "0"}, false},
+		{&ast.StringValue{Value: "true"}, true},
+		{&ast.StringValue{Value: "false"}, false},
+		{&ast.FloatValue{Value: "1.0"}, nil},
+	}
+	for _, test := range tests {
+		if val := graphql.Boolean.ParseLiteral(test.Value); val != test.Expected {
+			t.Fatalf("failed Boolean.ParseLiteral(%#v), expected: %v, got %v", test.Value, test.Expected, val)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_ParseValueIDMatchesLiteral(t *testing.T) {
+	expected := "9007199254740993"
+	if result := graphql.ID.ParseLiteral(&ast.IntValue{Value: expected}); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	for _, value := range []interface{}{
+		json.Number(expected),
+		int64(9007199254740993),
+		uint64(9007199254740993),
+	} {
+		if result := graphql.ID.ParseValue(value); result != expected {
+			t.Fatalf("Expected %v for %T, got: %v", expected, value, result)
+		}
+	}
+	if result := graphql.ID.ParseValue(float64(1e18)); result != "1000000000000000000" {
+		t.Fatalf("Expected 1000000000000000000, got: %v", result)
+	}
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// phoneExtensionRegexp matches an extension with an optional `x`, `ext` or
+// `extension` prefix, such as `x123` or `ext. 123`.
+var phoneExtensionRegexp = regexp.MustCompile(`^(?:(?:x|ext|extension)\.?\s*)?(\d{1,6})$`)
+
+func coercePhoneExtension(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		m := phoneExtensionRegexp.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+		if m == nil {
+			return nil
+		}
+		return m[1]
+	case *string:
+		return coercePhoneExtension(*value)
+	}
+	return nil
+}
+
+// PhoneExtension is the GraphQL phone extension type definition.
+var PhoneExtension = NewScalar(ScalarConfig{
+	Name: "PhoneExtension",
+	Description: "The `PhoneExtension` scalar type represents a phone extension of 1 " +
+		"to 6 digits. Input may carry a prefix such as `x` or `ext.`, which is " +
+		"stripped.",
+	Serialize:  coercePhoneExtension,
+	ParseValue: coercePhoneExtension,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coercePhoneExtension(valueAST.Value)
+		}
+		return nil
+	},
+})
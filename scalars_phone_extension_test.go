@@ -0,0 +1,24 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_PhoneExtension_StripsPrefix(t *testing.T) {
+	for _, value := range []string{"ext. 123", "x123", "Ext123", "123"} {
+		if result := graphql.PhoneExtension.ParseLiteral(&ast.StringValue{Value: value}); result != "123" {
+			t.Fatalf("Expected 123 for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_PhoneExtension_RejectsInvalidExtensions(t *testing.T) {
+	for _, value := range []string{"abc", "x1234567", "ext."} {
+		if result := graphql.PhoneExtension.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var e164Regexp = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// countryCallingCodes holds common ITU country calling codes. Calling codes
+// form a prefix code, so a number starts with at most one of them.
+var countryCallingCodes = map[string]bool{
+	"1": true, "7": true, "20": true, "27": true, "30": true, "31": true,
+	"32": true, "33": true, "34": true, "36": true, "39": true, "40": true,
+	"41": true, "43": true, "44": true, "45": true, "46": true, "47": true,
+	"48": true, "49": true, "51": true, "52": true, "53": true, "54": true,
+	"55": true, "56": true, "57": true, "58": true, "60": true, "61": true,
+	"62": true, "63": true, "64": true, "65": true, "66": true, "81": true,
+	"82": true, "84": true, "86": true, "90": true, "91": true, "92": true,
+	"93": true, "94": true, "95": true, "98": true, "212": true, "213": true,
+	"216": true, "234": true, "254": true, "351": true, "352": true, "353": true,
+	"354": true, "358": true, "370": true, "371": true, "372": true, "380": true,
+	"385": true, "386": true, "420": true, "421": true, "852": true, "886": true,
+	"966": true, "971": true, "972": true,
+}
+
+// PhoneNumberComponents holds an E.164 phone number along with its country
+// calling code, without the leading `+`.
+type PhoneNumberComponents struct {
+	Number      string
+	CountryCode string
+}
+
+// ParsePhoneNumber validates an E.164 phone number, such as `+14155550123`,
+// and splits off its country calling code. Numbers whose calling code is not
+// known are rejected.
+func ParsePhoneNumber(number string) (PhoneNumberComponents, bool) {
+	if !e164Regexp.MatchString(number) {
+		return PhoneNumberComponents{}, false
+	}
+	for length := 1; length <= 3; length++ {
+		if code := number[1 : 1+length]; countryCallingCodes[code] {
+			return PhoneNumberComponents{Number: number, CountryCode: code}, true
+		}
+	}
+	return PhoneNumberComponents{}, false
+}
+
+func serializePhoneNumberParsed(value interface{}) interface{} {
+	switch value := value.(type) {
+	case PhoneNumberComponents:
+		return serializePhoneNumberParsed(value.Number)
+	case *PhoneNumberComponents:
+		return serializePhoneNumberParsed(*value)
+	case string:
+		if _, ok := ParsePhoneNumber(value); !ok {
+			return nil
+		}
+		return value
+	case *string:
+		return serializePhoneNumberParsed(*value)
+	}
+	return nil
+}
+
+func unserializePhoneNumberParsed(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		// Spaces and dashes are allowed as visual separators on input.
+		number := strings.NewReplacer(" ", "", "-", "").Replace(value)
+		components, ok := ParsePhoneNumber(number)
+		if !ok {
+			return nil
+		}
+		return components
+	case *string:
+		return unserializePhoneNumberParsed(*value)
+	}
+	return nil
+}
+
+// PhoneNumberParsed is the GraphQL E.164 phone number type definition.
+// Parsed values are PhoneNumberComponents.
+var PhoneNumberParsed = NewScalar(ScalarConfig{
+	Name: "PhoneNumberParsed",
+	Description: "The `PhoneNumberParsed` scalar type represents a phone number in " +
+		"E.164 format, such as `+14155550123`, serialized as a string.",
+	Serialize:  serializePhoneNumberParsed,
+	ParseValue: unserializePhoneNumberParsed,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializePhoneNumberParsed(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,32 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_PhoneNumberParsed_ExtractsCountryCode(t *testing.T) {
+	for value, expected := range map[string]graphql.PhoneNumberComponents{
+		"+14155550123":     {Number: "+14155550123", CountryCode: "1"},
+		"+44 20 7946 0958": {Number: "+442079460958", CountryCode: "44"},
+		"+353-1-234-5678":  {Number: "+35312345678", CountryCode: "353"},
+	} {
+		if result := graphql.PhoneNumberParsed.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+	components := graphql.PhoneNumberComponents{Number: "+14155550123", CountryCode: "1"}
+	if result := graphql.PhoneNumberParsed.Serialize(components); result != "+14155550123" {
+		t.Fatalf("Expected +14155550123, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_PhoneNumberParsed_RejectsInvalidNumbers(t *testing.T) {
+	for _, value := range []string{"14155550123", "+0123456", "+1415555012345678", "+8881234567"} {
+		if result := graphql.PhoneNumberParsed.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
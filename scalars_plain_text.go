@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"regexp"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// htmlTagRegexp matches opening, closing and self-closing tags as well as
+// comments and doctypes. A lone `<` as in `a < b` is not a tag.
+var htmlTagRegexp = regexp.MustCompile(`</?[A-Za-z!][^<>]*>`)
+
+// NewPlainTextScalar creates a `PlainText` scalar for strings without HTML
+// tags. Input containing tags is rejected, or, when stripTags is set, has
+// its tags removed, in which case the scalar is named `TagStrippedPlainText`.
+func NewPlainTextScalar(stripTags bool) *Scalar {
+	name := "PlainText"
+	if stripTags {
+		name = "TagStrippedPlainText"
+	}
+	var parse func(value interface{}) interface{}
+	parse = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if !htmlTagRegexp.MatchString(value) {
+				return value
+			}
+			if stripTags {
+				return htmlTagRegexp.ReplaceAllString(value, "")
+			}
+		case *string:
+			return parse(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents textual data that " +
+			"contains no HTML tags.",
+		Serialize:  coerceString,
+		ParseValue: parse,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return parse(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
+
+// PlainText is the GraphQL tag-free text type definition; input containing
+// HTML tags is rejected.
+var PlainText = NewPlainTextScalar(false)
@@ -0,0 +1,40 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_PlainText_RejectsTags(t *testing.T) {
+	if result := graphql.PlainText.ParseValue("hello <b>world</b>"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := graphql.PlainText.ParseLiteral(&ast.StringValue{Value: "<script src=x>"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_PlainText_AcceptsCleanText(t *testing.T) {
+	for _, value := range []string{"hello world", "1 < 2 and 3 > 2"} {
+		if result := graphql.PlainText.ParseValue(value); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_PlainText_StripsTags(t *testing.T) {
+	plainText := graphql.NewPlainTextScalar(true)
+	if result := plainText.ParseValue("hello <b>world</b>"); result != "hello world" {
+		t.Fatalf("Expected hello world, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_PlainText_StrippingScalarHasItsOwnName(t *testing.T) {
+	stripped := graphql.NewPlainTextScalar(true)
+	if stripped.Name() != "TagStrippedPlainText" {
+		t.Fatalf("Expected TagStrippedPlainText, got: %v", stripped.Name())
+	}
+	assertScalarsCoexist(t, graphql.PlainText, stripped)
+}
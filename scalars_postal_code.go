@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type postalCodeFormat struct {
+	pattern *regexp.Regexp
+	format  func(match []string) string
+}
+
+func joinPostalCode(sep string) func(match []string) string {
+	return func(match []string) string {
+		parts := []string{}
+		for _, part := range match[1:] {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		return strings.Join(parts, sep)
+	}
+}
+
+// postalCodeFormats holds, per ISO 3166-1 alpha-2 country code, the pattern a
+// postal code must match once whitespace is removed and letters are
+// uppercased, and how to rebuild the canonical form from its submatches.
+var postalCodeFormats = map[string]postalCodeFormat{
+	"US": {regexp.MustCompile(`^(\d{5})(?:-?(\d{4}))?$`), joinPostalCode("-")},
+	"CA": {regexp.MustCompile(`^([ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z])(\d[ABCEGHJ-NPRSTV-Z]\d)$`), joinPostalCode(" ")},
+	"GB": {regexp.MustCompile(`^([A-Z]{1,2}\d[A-Z\d]?)(\d[A-Z]{2})$`), joinPostalCode(" ")},
+	"DE": {regexp.MustCompile(`^(\d{5})$`), joinPostalCode("")},
+}
+
+func (f postalCodeFormat) normalize(value string) interface{} {
+	value = strings.ToUpper(strings.Join(strings.Fields(value), ""))
+	match := f.pattern.FindStringSubmatch(value)
+	if match == nil {
+		return nil
+	}
+	return f.format(match)
+}
+
+// NewPostalCodeScalar creates a scalar named `PostalCode<country>` that
+// validates and normalizes postal codes of the given country. Supported
+// countries are US, CA, GB and DE.
+func NewPostalCodeScalar(country string) *Scalar {
+	country = strings.ToUpper(country)
+	format, ok := postalCodeFormats[country]
+	if !ok {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`Postal codes for country "%v" are not supported.`, country)),
+		}
+	}
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			return format.normalize(value)
+		case *string:
+			return coerce(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: "PostalCode" + country,
+		Description: fmt.Sprintf("The `PostalCode%v` scalar type represents a postal "+
+			"code of country %v in its canonical form.", country, country),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_PostalCode_AcceptsUSZipCode(t *testing.T) {
+	postalCode := graphql.NewPostalCodeScalar("US")
+	if result := postalCode.ParseValue("94107"); result != "94107" {
+		t.Fatalf("Expected 94107, got: %v", result)
+	}
+	if result := postalCode.ParseValue("941071234"); result != "94107-1234" {
+		t.Fatalf("Expected 94107-1234, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_PostalCode_NormalizesGBPostcode(t *testing.T) {
+	postalCode := graphql.NewPostalCodeScalar("GB")
+	expected := "SW1A 1AA"
+	if result := postalCode.ParseLiteral(&ast.StringValue{Value: "sw1a1aa"}); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_PostalCode_RejectsInvalidUSZipCode(t *testing.T) {
+	postalCode := graphql.NewPostalCodeScalar("US")
+	if result := postalCode.ParseValue("9410"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_PostalCode_UnsupportedCountryIsAnError(t *testing.T) {
+	postalCode := graphql.NewPostalCodeScalar("XX")
+	if postalCode.Error() == nil {
+		t.Fatalf("Expected an error for an unsupported country")
+	}
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"net/url"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func serializeQueryString(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string][]string:
+		return url.Values(value).Encode()
+	case url.Values:
+		return value.Encode()
+	case *url.Values:
+		return value.Encode()
+	case string:
+		if _, err := url.ParseQuery(value); err != nil {
+			return nil
+		}
+		return value
+	case *string:
+		return serializeQueryString(*value)
+	}
+	return nil
+}
+
+func unserializeQueryString(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		values, err := url.ParseQuery(value)
+		if err != nil {
+			return nil
+		}
+		return map[string][]string(values)
+	case *string:
+		return unserializeQueryString(*value)
+	}
+	return nil
+}
+
+// QueryString is the GraphQL URL query string type definition. Parsed values
+// are a map[string][]string of each key to its values.
+var QueryString = NewScalar(ScalarConfig{
+	Name: "QueryString",
+	Description: "The `QueryString` scalar type represents URL-encoded `key=value` " +
+		"pairs separated by `&`, such as `a=1&b=2`.",
+	Serialize:  serializeQueryString,
+	ParseValue: unserializeQueryString,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeQueryString(valueAST.Value)
+		}
+		return nil
+	},
+})
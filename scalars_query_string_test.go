@@ -0,0 +1,31 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_QueryString_ParsesMultipleValues(t *testing.T) {
+	expected := map[string][]string{
+		"a": {"1", "2"},
+		"b": {"3"},
+	}
+	result := graphql.QueryString.ParseLiteral(&ast.StringValue{Value: "a=1&a=2&b=3"})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if serialized := graphql.QueryString.Serialize(expected); serialized != "a=1&a=2&b=3" {
+		t.Fatalf("Expected a=1&a=2&b=3, got: %v", serialized)
+	}
+}
+
+func TestTypeSystem_Scalar_QueryString_RejectsMalformedInput(t *testing.T) {
+	for _, value := range []string{"a=%zz", "a=1;b=2"} {
+		if result := graphql.QueryString.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
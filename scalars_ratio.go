@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"math"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceRatio(value interface{}) interface{} {
+	f, err := CoerceFloatE(value)
+	if err != nil || math.IsNaN(f) || f < 0 || f > 1 {
+		return nil
+	}
+	return f
+}
+
+// coercePercentRatio converts a whole-number percentage in [0, 100] to a
+// ratio in [0, 1].
+func coercePercentRatio(value interface{}) interface{} {
+	f, err := CoerceFloatE(value)
+	if err != nil || math.IsNaN(f) || f < 0 || f > 100 {
+		return nil
+	}
+	return f / 100
+}
+
+func parseLiteralFloat(coerce func(interface{}) interface{}) ParseLiteralFn {
+	return func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.FloatValue:
+			return coerce(valueAST.Value)
+		case *ast.IntValue:
+			return coerce(valueAST.Value)
+		}
+		return nil
+	}
+}
+
+// Ratio is the GraphQL ratio type definition. A ratio of 0.15 stands for
+// 15%; values above 1 are rejected so that whole-number percentages are not
+// mistaken for ratios.
+var Ratio = NewScalar(ScalarConfig{
+	Name: "Ratio",
+	Description: "The `Ratio` scalar type represents a proportion between 0 and 1 " +
+		"inclusive, such as `0.15` for 15%.",
+	Serialize:    coerceRatio,
+	ParseValue:   coerceRatio,
+	ParseLiteral: parseLiteralFloat(coerceRatio),
+})
+
+// PercentRatio is the GraphQL whole-number percentage type definition. Input
+// such as `15` is parsed to the ratio 0.15, and ratios are serialized as
+// ratios, like Ratio does.
+var PercentRatio = NewScalar(ScalarConfig{
+	Name: "PercentRatio",
+	Description: "The `PercentRatio` scalar type represents a proportion given as a " +
+		"percentage between 0 and 100 inclusive, such as `15` for 15%, and " +
+		"returned as a ratio between 0 and 1.",
+	Serialize:    coerceRatio,
+	ParseValue:   coercePercentRatio,
+	ParseLiteral: parseLiteralFloat(coercePercentRatio),
+})
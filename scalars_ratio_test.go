@@ -0,0 +1,29 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Ratio_AcceptsOnlyUnitInterval(t *testing.T) {
+	if result := graphql.Ratio.ParseLiteral(&ast.FloatValue{Value: "0.15"}); result != 0.15 {
+		t.Fatalf("Expected 0.15, got: %v", result)
+	}
+	if result := graphql.Ratio.ParseLiteral(&ast.IntValue{Value: "15"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := graphql.Ratio.ParseValue(-0.1); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_PercentRatio_ConvertsPercentToRatio(t *testing.T) {
+	if result := graphql.PercentRatio.ParseLiteral(&ast.IntValue{Value: "15"}); result != 0.15 {
+		t.Fatalf("Expected 0.15, got: %v", result)
+	}
+	if result := graphql.PercentRatio.ParseValue(150); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var relativeTimeRegexp = regexp.MustCompile(`^([+-])(\d+)([smhdw])$`)
+
+var relativeTimeUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+func unserializeRelativeTime(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if value == "now" {
+			return time.Now()
+		}
+		if m := relativeTimeRegexp.FindStringSubmatch(value); m != nil {
+			n, err := strconv.ParseInt(m[2], 10, 64)
+			unit := relativeTimeUnits[m[3]]
+			// Offsets that do not fit in a time.Duration would wrap around.
+			if err != nil || n > math.MaxInt64/int64(unit) {
+				return nil
+			}
+			offset := time.Duration(n) * unit
+			if m[1] == "-" {
+				offset = -offset
+			}
+			return time.Now().Add(offset)
+		}
+		return unserializeDateTime(value)
+	case *string:
+		return unserializeRelativeTime(*value)
+	}
+	return nil
+}
+
+// RelativeTime is the GraphQL absolute or relative time type definition.
+var RelativeTime = NewScalar(ScalarConfig{
+	Name: "RelativeTime",
+	Description: "The `RelativeTime` scalar type represents a point in time, given " +
+		"either as an RFC 3339 string, as \"now\", or as an offset from now such " +
+		"as \"-2d\" or \"+1h\". It is serialized as an RFC 3339 string.",
+	Serialize:  serializeDateTime,
+	ParseValue: unserializeRelativeTime,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeRelativeTime(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,42 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_RelativeTime_ParsesOffsetFromNow(t *testing.T) {
+	result, ok := graphql.RelativeTime.ParseLiteral(&ast.StringValue{Value: "-1h"}).(time.Time)
+	if !ok {
+		t.Fatalf("Expected a time.Time, got: %v", result)
+	}
+	if ago := time.Since(result); ago < 59*time.Minute || ago > 61*time.Minute {
+		t.Fatalf("Expected roughly an hour ago, got: %v", ago)
+	}
+	rfc3339 := "2017-07-23T03:46:56Z"
+	if result := graphql.RelativeTime.ParseValue(rfc3339); result != time.Date(2017, 7, 23, 3, 46, 56, 0, time.UTC) {
+		t.Fatalf("Expected %v, got: %v", rfc3339, result)
+	}
+}
+
+func TestTypeSystem_Scalar_RelativeTime_RejectsUnparsableValues(t *testing.T) {
+	for _, value := range []string{"tomorrow-ish", "-1y", "1h"} {
+		if result := graphql.RelativeTime.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_RelativeTime_RejectsOverflowingOffsets(t *testing.T) {
+	for _, value := range []string{"+1000000d", "-1000000d", "+20000w", "+9223372036854775808s"} {
+		if result := graphql.RelativeTime.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.RelativeTime.ParseValue("+100000d"); result == nil {
+		t.Fatalf("Expected an offset that fits in a duration to be accepted")
+	}
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// RGBAColor is the value of the RGBA scalar. HasAlpha reports whether the
+// color was given with an alpha channel.
+type RGBAColor struct {
+	R, G, B, A uint8
+	HasAlpha   bool
+}
+
+// rgbaFromInts builds an RGBAColor from 3 or 4 channel values in [0,255].
+func rgbaFromInts(channels []int) interface{} {
+	if len(channels) != 3 && len(channels) != 4 {
+		return nil
+	}
+	for _, c := range channels {
+		if c < 0 || c > 255 {
+			return nil
+		}
+	}
+	color := RGBAColor{R: uint8(channels[0]), G: uint8(channels[1]), B: uint8(channels[2])}
+	if len(channels) == 4 {
+		color.A = uint8(channels[3])
+		color.HasAlpha = true
+	}
+	return color
+}
+
+func parseRGBA(value interface{}) interface{} {
+	switch value := value.(type) {
+	case RGBAColor:
+		return value
+	case *RGBAColor:
+		return *value
+	}
+	values := reflect.ValueOf(value)
+	if values.Kind() != reflect.Slice && values.Kind() != reflect.Array {
+		return nil
+	}
+	channels := []int{}
+	for i := 0; i < values.Len(); i++ {
+		c, err := coerceIntE(values.Index(i).Interface(), false)
+		if err != nil {
+			return nil
+		}
+		channels = append(channels, c)
+	}
+	return rgbaFromInts(channels)
+}
+
+func serializeRGBA(value interface{}) interface{} {
+	color, ok := parseRGBA(value).(RGBAColor)
+	if !ok {
+		return nil
+	}
+	if color.HasAlpha {
+		return []int{int(color.R), int(color.G), int(color.B), int(color.A)}
+	}
+	return []int{int(color.R), int(color.G), int(color.B)}
+}
+
+// RGBA is the GraphQL RGB(A) color type definition.
+var RGBA = NewScalar(ScalarConfig{
+	Name: "RGBA",
+	Description: "The `RGBA` scalar type represents a color as a list of three (red, " +
+		"green, blue) or four (red, green, blue, alpha) integers between 0 and 255.",
+	Serialize:  serializeRGBA,
+	ParseValue: parseRGBA,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		listValue, ok := valueAST.(*ast.ListValue)
+		if !ok {
+			return nil
+		}
+		channels := []int{}
+		for _, itemAST := range listValue.Values {
+			intValue, ok := itemAST.(*ast.IntValue)
+			if !ok {
+				return nil
+			}
+			c, err := strconv.Atoi(intValue.Value)
+			if err != nil {
+				return nil
+			}
+			channels = append(channels, c)
+		}
+		return rgbaFromInts(channels)
+	},
+})
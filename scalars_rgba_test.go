@@ -0,0 +1,55 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func rgbaLiteral(channels ...string) *ast.ListValue {
+	values := []ast.Value{}
+	for _, c := range channels {
+		values = append(values, &ast.IntValue{Value: c})
+	}
+	return &ast.ListValue{Values: values}
+}
+
+func TestTypeSystem_Scalar_RGBA_ParsesThreeAndFourChannels(t *testing.T) {
+	expected := graphql.RGBAColor{R: 255}
+	if result := graphql.RGBA.ParseLiteral(rgbaLiteral("255", "0", "0")); result != expected {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+	expected = graphql.RGBAColor{R: 255, A: 128, HasAlpha: true}
+	if result := graphql.RGBA.ParseLiteral(rgbaLiteral("255", "0", "0", "128")); result != expected {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+	if result := graphql.RGBA.ParseValue([]interface{}{255.0, 0.0, 0.0, 128.0}); result != expected {
+		t.Fatalf("Expected %+v, got: %+v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_RGBA_RejectsOutOfRangeAndWrongLength(t *testing.T) {
+	if result := graphql.RGBA.ParseLiteral(rgbaLiteral("256", "0", "0")); result != nil {
+		t.Fatalf("Expected nil, got: %+v", result)
+	}
+	if result := graphql.RGBA.ParseLiteral(rgbaLiteral("255", "0")); result != nil {
+		t.Fatalf("Expected nil, got: %+v", result)
+	}
+	if result := graphql.RGBA.ParseValue([]int{0, -1, 0}); result != nil {
+		t.Fatalf("Expected nil, got: %+v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_RGBA_SerializesArrayForm(t *testing.T) {
+	expected := []int{255, 0, 0}
+	if result := graphql.RGBA.Serialize(graphql.RGBAColor{R: 255}); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	expected = []int{1, 2, 3, 4}
+	color := graphql.RGBAColor{R: 1, G: 2, B: 3, A: 4, HasAlpha: true}
+	if result := graphql.RGBA.Serialize(&color); !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
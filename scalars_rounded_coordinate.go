@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// CoordinateKind selects whether a NewRoundedCoordinateScalar scalar holds a
+// latitude or a longitude.
+type CoordinateKind int
+
+// Coordinate kinds.
+const (
+	// Latitude values lie within [-90, 90].
+	Latitude CoordinateKind = iota
+	// Longitude values lie within [-180, 180].
+	Longitude
+)
+
+// NewRoundedCoordinateScalar creates a `RoundedLatitude` or
+// `RoundedLongitude` scalar, depending on kind, for a coordinate in degrees.
+// Values are rounded to the given number of decimals on both input and
+// output so that no more precision than that is ever exposed, and values
+// outside the range of kind are rejected.
+func NewRoundedCoordinateScalar(decimals int, kind CoordinateKind) *Scalar {
+	var name, description string
+	var limit float64
+	switch kind {
+	case Latitude:
+		name, description, limit = "RoundedLatitude", "latitude", 90
+	case Longitude:
+		name, description, limit = "RoundedLongitude", "longitude", 180
+	default:
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`Unknown coordinate kind %v.`, kind)),
+		}
+	}
+	if decimals < 0 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`Coordinate decimals must not be negative, got: %v.`, decimals)),
+		}
+	}
+	scale := math.Pow(10, float64(decimals))
+	coerce := func(value interface{}) interface{} {
+		f, err := CoerceFloatE(value)
+		if err != nil || math.IsNaN(f) || f < -limit || f > limit {
+			return nil
+		}
+		// Round half away from zero.
+		if f < 0 {
+			return -math.Floor(-f*scale+0.5) / scale
+		}
+		return math.Floor(f*scale+0.5) / scale
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents a %v in degrees, "+
+			"rounded to %v decimals.", name, description, decimals),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.FloatValue:
+				return coerce(valueAST.Value)
+			case *ast.IntValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_RoundedCoordinate_RoundsToDecimals(t *testing.T) {
+	latitude := graphql.NewRoundedCoordinateScalar(2, graphql.Latitude)
+	if result := latitude.ParseLiteral(&ast.FloatValue{Value: "37.774929"}); result != 37.77 {
+		t.Fatalf("Expected 37.77, got: %v", result)
+	}
+	longitude := graphql.NewRoundedCoordinateScalar(2, graphql.Longitude)
+	if result := longitude.Serialize(-122.419416); result != -122.42 {
+		t.Fatalf("Expected -122.42, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_RoundedCoordinate_ChecksRangeBoundaries(t *testing.T) {
+	tests := []struct {
+		scalar   *graphql.Scalar
+		value    float64
+		expected interface{}
+	}{
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Latitude), 90, 90.0},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Latitude), -90, -90.0},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Latitude), 90.5, nil},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Latitude), -90.5, nil},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Latitude), 120, nil},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Longitude), 120, 120.0},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Longitude), 180, 180.0},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Longitude), -180, -180.0},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Longitude), 180.5, nil},
+		{graphql.NewRoundedCoordinateScalar(2, graphql.Longitude), -180.5, nil},
+	}
+	for _, test := range tests {
+		if result := test.scalar.ParseValue(test.value); result != test.expected {
+			t.Fatalf("Expected %v for %v of %v, got: %v", test.expected, test.scalar.Name(), test.value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_RoundedCoordinate_NamesKind(t *testing.T) {
+	if name := graphql.NewRoundedCoordinateScalar(2, graphql.Latitude).Name(); name != "RoundedLatitude" {
+		t.Fatalf("Expected RoundedLatitude, got: %v", name)
+	}
+	if name := graphql.NewRoundedCoordinateScalar(2, graphql.Longitude).Name(); name != "RoundedLongitude" {
+		t.Fatalf("Expected RoundedLongitude, got: %v", name)
+	}
+	if err := graphql.NewRoundedCoordinateScalar(2, graphql.CoordinateKind(2)).Error(); err == nil {
+		t.Fatalf("Expected an unknown coordinate kind to be rejected")
+	}
+}
@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// parseSafeString rejects strings containing C0 control characters other
+// than tab, line feed and carriage return.
+func parseSafeString(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if strings.IndexFunc(value, func(r rune) bool {
+			return r < 0x20 && r != '\t' && r != '\n' && r != '\r'
+		}) >= 0 {
+			return nil
+		}
+		return value
+	case *string:
+		return parseSafeString(*value)
+	}
+	return nil
+}
+
+// SafeString is the GraphQL control-character-free string type definition.
+var SafeString = NewScalar(ScalarConfig{
+	Name: "SafeString",
+	Description: "The `SafeString` scalar type represents textual data that contains " +
+		"no control characters other than tab, line feed and carriage return.",
+	Serialize:  coerceString,
+	ParseValue: parseSafeString,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return parseSafeString(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,26 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_SafeString_AcceptsMultiLineString(t *testing.T) {
+	expected := "first line\n\tsecond line\r\n"
+	if result := graphql.SafeString.ParseValue(expected); result != expected {
+		t.Fatalf("Expected %q, got: %q", expected, result)
+	}
+	if result := graphql.SafeString.ParseLiteral(&ast.StringValue{Value: expected}); result != expected {
+		t.Fatalf("Expected %q, got: %q", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_SafeString_RejectsControlCharacters(t *testing.T) {
+	for _, value := range []string{"null\x00byte", "bell\x07", "escape\x1b[0m"} {
+		if result := graphql.SafeString.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %q", value, result)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// semverPartialRegexp matches a full or partial version such as `1`, `1.2`,
+// `1.x` or `1.2.3-beta.1+build`, with an optional leading `v`.
+var semverPartialRegexp = regexp.MustCompile(`^v?(0|[1-9]\d*|[xX*])` +
+	`(\.(0|[1-9]\d*|[xX*])` +
+	`(\.(0|[1-9]\d*|[xX*])` +
+	`(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?` +
+	`(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?)?)?$`)
+
+var semverOperators = []string{"<=", ">=", "<", ">", "=", "^", "~"}
+
+// validSemverComparator reports whether s is a partial version, optionally
+// prefixed by one of the comparison, caret or tilde operators.
+func validSemverComparator(s string) bool {
+	for _, op := range semverOperators {
+		if strings.HasPrefix(s, op) {
+			s = s[len(op):]
+			break
+		}
+	}
+	return semverPartialRegexp.MatchString(s)
+}
+
+// validSemverRange reports whether s follows the npm range grammar: one or
+// more `||`-separated sets, each a hyphen range (`1.2.3 - 2.3.4`) or a
+// space-separated list of comparators.
+func validSemverRange(s string) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+	for _, set := range strings.Split(s, "||") {
+		parts := strings.Fields(set)
+		if len(parts) == 0 {
+			return false
+		}
+		if len(parts) == 3 && parts[1] == "-" {
+			if !semverPartialRegexp.MatchString(parts[0]) || !semverPartialRegexp.MatchString(parts[2]) {
+				return false
+			}
+			continue
+		}
+		for _, part := range parts {
+			if !validSemverComparator(part) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func coerceSemverRange(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !validSemverRange(value) {
+			return nil
+		}
+		return value
+	case *string:
+		return coerceSemverRange(*value)
+	}
+	return nil
+}
+
+// SemverRange is the GraphQL semantic version range type definition.
+var SemverRange = NewScalar(ScalarConfig{
+	Name: "SemverRange",
+	Description: "The `SemverRange` scalar type represents a range of semantic " +
+		"versions in npm syntax, such as `^1.2.0` or `>=1.0.0 <2.0.0`.",
+	Serialize:  coerceSemverRange,
+	ParseValue: coerceSemverRange,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceSemverRange(valueAST.Value)
+		}
+		return nil
+	},
+})
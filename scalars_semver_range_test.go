@@ -0,0 +1,35 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_SemverRange_AcceptsCommonRanges(t *testing.T) {
+	for _, value := range []string{
+		"^1.2.3",
+		">=1.0.0 <2.0.0",
+		"~1.2",
+		"1.x || >=2.5.0",
+		"1.2.3 - 2.3.4",
+		"*",
+		"=1.0.0-beta.1+build.5",
+	} {
+		if result := graphql.SemverRange.ParseValue(value); result != value {
+			t.Fatalf("Expected %q, got: %v", value, result)
+		}
+	}
+	if result := graphql.SemverRange.ParseLiteral(&ast.StringValue{Value: "^1.2.3"}); result != "^1.2.3" {
+		t.Fatalf("Expected ^1.2.3, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_SemverRange_RejectsInvalidRanges(t *testing.T) {
+	for _, value := range []string{"notarange", "", "^01.2.3", ">=1.0.0 ||", "1.2.3 -"} {
+		if result := graphql.SemverRange.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
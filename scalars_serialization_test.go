@@ -1,8 +1,10 @@
 package graphql_test
 
 import (
+	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -197,3 +199,209 @@ func TestTypeSystem_Scalar_SerializeOutputDateTime(t *testing.T) {
 		}
 	}
 }
+
+func TestTypeSystem_Scalar_SerializeReportsToMetricsHook(t *testing.T) {
+	type call struct {
+		ScalarName string
+		Failed     bool
+	}
+	calls := []call{}
+	graphql.SetScalarMetricsHook(func(scalarName string, failed bool) {
+		calls = append(calls, call{scalarName, failed})
+	})
+	defer graphql.SetScalarMetricsHook(nil)
+
+	graphql.Int.Serialize("one")
+	graphql.String.Serialize("one")
+	graphql.DateTimeSeconds.Serialize("one")
+	graphql.NewDateTimeScalarLoc(time.UTC).Serialize(time.Time{})
+	graphql.NewMultiLayoutDateTimeScalar([]string{time.Kitchen}).Serialize("one")
+	graphql.Opacity.Serialize(0.5)
+	graphql.NewScalar(graphql.ScalarConfig{
+		Name: "Custom",
+		Serialize: func(value interface{}) interface{} {
+			return nil
+		},
+	}).Serialize("one")
+
+	expected := []call{
+		{"Int", true},
+		{"String", false},
+		{"DateTimeSeconds", true},
+		{"DateTimeUTC", false},
+		{"DateTime304PM", true},
+		{"Opacity", false},
+		{"Custom", true},
+	}
+	if !reflect.DeepEqual(expected, calls) {
+		t.Fatalf("Expected %v, got: %v", expected, calls)
+	}
+}
+
+func TestTypeSystem_Scalar_CoerceIntEReportsNonIntegerFloat(t *testing.T) {
+	_, err := graphql.CoerceIntE(3.5)
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "non-integer float") {
+		t.Fatalf("Expected error to mention a non-integer float, got: %v", err)
+	}
+	if val, err := graphql.CoerceIntE(3.0); err != nil || val != 3 {
+		t.Fatalf("Expected 3, got: %v, %v", val, err)
+	}
+}
+
+func TestTypeSystem_Scalar_CoercersReportErrors(t *testing.T) {
+	if _, err := graphql.CoerceIntE(int64(math.MaxInt32) + 1); err == nil {
+		t.Fatalf("Expected CoerceIntE to report an out of range value")
+	}
+	if _, err := graphql.CoerceFloatE("one"); err == nil {
+		t.Fatalf("Expected CoerceFloatE to report a non-numeric string")
+	}
+	if _, err := graphql.CoerceBoolE([]int{}); err == nil {
+		t.Fatalf("Expected CoerceBoolE to report an unsupported type")
+	}
+	if _, err := graphql.CoerceStringE(nil); err == nil {
+		t.Fatalf("Expected CoerceStringE to report a nil value")
+	}
+}
+
+func TestTypeSystem_Scalar_CoercersKeepFallbackOutputs(t *testing.T) {
+	if val := graphql.String.Serialize(nil); val != "<nil>" {
+		t.Fatalf("Expected String.Serialize(nil) to be %q, got: %v", "<nil>", val)
+	}
+	if val := graphql.String.Serialize([]int{1, 2}); val != "[1 2]" {
+		t.Fatalf("Expected String.Serialize([]int{1, 2}) to be %q, got: %v", "[1 2]", val)
+	}
+	if val := graphql.String.ParseValue(nil); val != "<nil>" {
+		t.Fatalf("Expected String.ParseValue(nil) to be %q, got: %v", "<nil>", val)
+	}
+	if val := graphql.Float.Serialize([]int{1}); val != 0.0 {
+		t.Fatalf("Expected Float.Serialize([]int{1}) to be 0, got: %v", val)
+	}
+	if val := graphql.Float.Serialize(nil); val != 0.0 {
+		t.Fatalf("Expected Float.Serialize(nil) to be 0, got: %v", val)
+	}
+	if val := graphql.Float.Serialize("one"); val != nil {
+		t.Fatalf("Expected Float.Serialize(%q) to be nil, got: %v", "one", val)
+	}
+	if _, err := graphql.CoerceFloatE([]int{1}); err == nil {
+		t.Fatalf("Expected CoerceFloatE to report an unsupported type")
+	}
+}
+
+func TestTypeSystem_Scalar_FloatSerializesAllIntegerTypes(t *testing.T) {
+	three := 3
+	tests := []interface{}{
+		&three, int8(3), int16(3), int32(3), int64(3),
+		uint(3), uint8(3), uint16(3), uint32(3), uint64(3),
+	}
+	for _, value := range tests {
+		if val := graphql.Float.Serialize(value); val != 3.0 {
+			t.Fatalf("Expected Float.Serialize(%T) to be 3, got: %v", value, val)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_SerializeOutputDateTimeSeconds(t *testing.T) {
+	value := time.Date(2017, 7, 23, 3, 46, 56, 647000000, time.UTC)
+	expected := "2017-07-23T03:46:56Z"
+	if result := graphql.DateTimeSeconds.Serialize(value); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	parsed := graphql.DateTimeSeconds.ParseValue("2017-07-23T03:46:56.647Z")
+	if parsed != value.Truncate(time.Second) {
+		t.Fatalf("Expected %v, got: %v", value.Truncate(time.Second), parsed)
+	}
+}
+
+type stringerNumber struct {
+	digits string
+}
+
+func (n stringerNumber) String() string {
+	return n.digits
+}
+
+func TestTypeSystem_Scalar_SerializeStringerNumbers(t *testing.T) {
+	if result := graphql.Int.Serialize(stringerNumber{"42"}); result != 42 {
+		t.Fatalf("Expected 42, got: %v", result)
+	}
+	if result := graphql.Float.Serialize(stringerNumber{"4.5"}); result != 4.5 {
+		t.Fatalf("Expected 4.5, got: %v", result)
+	}
+	if result := graphql.Int.Serialize(stringerNumber{"many"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+type money struct {
+	cents int
+}
+
+func TestTypeSystem_Scalar_SerializeRegisteredTypeCoercion(t *testing.T) {
+	graphql.RegisterTypeCoercion(reflect.TypeOf(money{}), func(value interface{}) interface{} {
+		m := value.(money)
+		return fmt.Sprintf("%d.%02d", m.cents/100, m.cents%100)
+	})
+	defer graphql.UnregisterTypeCoercion(reflect.TypeOf(money{}))
+	if result := graphql.String.Serialize(money{1234}); result != "12.34" {
+		t.Fatalf("Expected 12.34, got: %v", result)
+	}
+	if result := graphql.Float.Serialize(money{1234}); result != 12.34 {
+		t.Fatalf("Expected 12.34, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_SerializeUnregisteredTypeCoercion(t *testing.T) {
+	graphql.RegisterTypeCoercion(reflect.TypeOf(money{}), func(value interface{}) interface{} {
+		return "registered"
+	})
+	graphql.UnregisterTypeCoercion(reflect.TypeOf(money{}))
+	if result := graphql.String.Serialize(money{1234}); result == "registered" {
+		t.Fatalf("Expected the coercion to be unregistered, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_SerializeStrictID(t *testing.T) {
+	type user struct {
+		Name string
+	}
+	if result := graphql.StrictID.Serialize(user{"alice"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := graphql.ID.Serialize(user{"alice"}); result != "{alice}" {
+		t.Fatalf("Expected ID to remain lenient, got: %v", result)
+	}
+	for _, test := range []stringSerializationTest{
+		{"abc", "abc"},
+		{int64(42), "42"},
+		{uint8(7), "7"},
+		{stringerNumber{"x1"}, "x1"},
+	} {
+		if result := graphql.StrictID.Serialize(test.Value); result != test.Expected {
+			t.Fatalf("Expected %v, got: %v", test.Expected, result)
+		}
+	}
+	if result := graphql.StrictID.Serialize(1.5); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_StrictIDCanBeUsedAlongsideID(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"id":       &graphql.Field{Type: graphql.ID},
+				"strictId": &graphql.Field{Type: graphql.StrictID},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	if schema.Type("ID") != graphql.ID || schema.Type("StrictID") != graphql.StrictID {
+		t.Fatalf("Expected the schema to contain both ID and StrictID")
+	}
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var signedPercentageRegexp = regexp.MustCompile(`^[+-]?\d+(\.\d+)?%$`)
+
+func serializeSignedPercentage(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		value = unserializeSignedPercentage(s)
+	}
+	f, err := CoerceFloatE(value)
+	if err != nil {
+		return nil
+	}
+	formatted := strconv.FormatFloat(f, 'f', -1, 64) + "%"
+	if f > 0 {
+		formatted = "+" + formatted
+	}
+	return formatted
+}
+
+func unserializeSignedPercentage(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		percentage := strings.TrimSpace(value)
+		if !signedPercentageRegexp.MatchString(percentage) {
+			return nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSuffix(percentage, "%"), 64)
+		if err != nil {
+			return nil
+		}
+		return f
+	case *string:
+		return unserializeSignedPercentage(*value)
+	}
+	return nil
+}
+
+// SignedPercentage is the GraphQL percentage change type definition. Parsed
+// values are float64 percentages, so `"+5%"` becomes 5.0.
+var SignedPercentage = NewScalar(ScalarConfig{
+	Name: "SignedPercentage",
+	Description: "The `SignedPercentage` scalar type represents a percentage change " +
+		"with an optional sign, such as `+5%` or `-3.2%`.",
+	Serialize:  serializeSignedPercentage,
+	ParseValue: unserializeSignedPercentage,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeSignedPercentage(valueAST.Value)
+		}
+		return nil
+	},
+})
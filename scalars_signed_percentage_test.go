@@ -0,0 +1,30 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_SignedPercentage_ParsesSignedPercentages(t *testing.T) {
+	if result := graphql.SignedPercentage.ParseLiteral(&ast.StringValue{Value: "+5%"}); result != 5.0 {
+		t.Fatalf("Expected 5.0, got: %v", result)
+	}
+	if result := graphql.SignedPercentage.ParseValue("-3.2%"); result != -3.2 {
+		t.Fatalf("Expected -3.2, got: %v", result)
+	}
+	for value, expected := range map[float64]string{5: "+5%", -3.2: "-3.2%", 0: "0%"} {
+		if result := graphql.SignedPercentage.Serialize(value); result != expected {
+			t.Fatalf("Expected %v, got: %v", expected, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_SignedPercentage_RejectsMissingPercent(t *testing.T) {
+	for _, value := range []string{"5", "+%", "5%%"} {
+		if result := graphql.SignedPercentage.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// SocialPlatform names a platform known to the SocialHandle scalar.
+type SocialPlatform string
+
+// Platforms accepted by the SocialHandle scalar.
+const (
+	SocialPlatformFacebook  SocialPlatform = "facebook"
+	SocialPlatformGitHub    SocialPlatform = "github"
+	SocialPlatformInstagram SocialPlatform = "instagram"
+	SocialPlatformLinkedIn  SocialPlatform = "linkedin"
+	SocialPlatformReddit    SocialPlatform = "reddit"
+	SocialPlatformTikTok    SocialPlatform = "tiktok"
+	SocialPlatformTwitter   SocialPlatform = "twitter"
+	SocialPlatformYouTube   SocialPlatform = "youtube"
+)
+
+// socialHandleRegexp matches a `platform:@handle`. Unlike handleRegexp, the
+// platform is required.
+var socialHandleRegexp = regexp.MustCompile(`^([A-Za-z]+):@?([A-Za-z0-9_.]{1,30})$`)
+
+var socialPlatforms = map[SocialPlatform]bool{
+	SocialPlatformFacebook:  true,
+	SocialPlatformGitHub:    true,
+	SocialPlatformInstagram: true,
+	SocialPlatformLinkedIn:  true,
+	SocialPlatformReddit:    true,
+	SocialPlatformTikTok:    true,
+	SocialPlatformTwitter:   true,
+	SocialPlatformYouTube:   true,
+}
+
+// SocialHandleComponents holds a user handle, without its `@`, along with
+// the platform it belongs to.
+type SocialHandleComponents struct {
+	Platform SocialPlatform
+	Handle   string
+}
+
+// String returns c as `platform:@handle`.
+func (c SocialHandleComponents) String() string {
+	return string(c.Platform) + ":@" + c.Handle
+}
+
+// ParseSocialHandle validates a platform-qualified handle, such as
+// `twitter:@user`, and splits it into its components. The platform is
+// matched case-insensitively against the known platforms, and the `@` may be
+// omitted.
+func ParseSocialHandle(handle string) (SocialHandleComponents, bool) {
+	m := socialHandleRegexp.FindStringSubmatch(handle)
+	if m == nil {
+		return SocialHandleComponents{}, false
+	}
+	platform := SocialPlatform(strings.ToLower(m[1]))
+	if !socialPlatforms[platform] {
+		return SocialHandleComponents{}, false
+	}
+	return SocialHandleComponents{Platform: platform, Handle: m[2]}, true
+}
+
+func serializeSocialHandle(value interface{}) interface{} {
+	switch value := value.(type) {
+	case SocialHandleComponents:
+		return serializeSocialHandle(value.String())
+	case *SocialHandleComponents:
+		return serializeSocialHandle(*value)
+	case string:
+		components, ok := ParseSocialHandle(value)
+		if !ok {
+			return nil
+		}
+		return components.String()
+	case *string:
+		return serializeSocialHandle(*value)
+	}
+	return nil
+}
+
+func unserializeSocialHandle(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		components, ok := ParseSocialHandle(value)
+		if !ok {
+			return nil
+		}
+		return components
+	case *string:
+		return unserializeSocialHandle(*value)
+	}
+	return nil
+}
+
+// SocialHandle is the GraphQL social media handle type definition. Parsed
+// values are SocialHandleComponents.
+var SocialHandle = NewScalar(ScalarConfig{
+	Name: "SocialHandle",
+	Description: "The `SocialHandle` scalar type represents a user handle on a " +
+		"known social platform, such as `twitter:@user`, serialized as a string.",
+	Serialize:  serializeSocialHandle,
+	ParseValue: unserializeSocialHandle,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeSocialHandle(valueAST.Value)
+		}
+		return nil
+	},
+})
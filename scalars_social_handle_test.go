@@ -0,0 +1,49 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_SocialHandle_ParsesComponents(t *testing.T) {
+	expected := graphql.SocialHandleComponents{
+		Platform: graphql.SocialPlatformTwitter,
+		Handle:   "user",
+	}
+	for _, value := range []string{"twitter:@user", "Twitter:user"} {
+		if result := graphql.SocialHandle.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_SocialHandle_Serializes(t *testing.T) {
+	value := graphql.SocialHandleComponents{Platform: graphql.SocialPlatformGitHub, Handle: "user"}
+	if result := graphql.SocialHandle.Serialize(value); result != "github:@user" {
+		t.Fatalf("Expected %v, got: %v", "github:@user", result)
+	}
+}
+
+func TestTypeSystem_Scalar_SocialHandle_RejectsInvalidHandles(t *testing.T) {
+	for _, value := range []string{"myspace:@x", "@user", "twitter:@"} {
+		if result := graphql.SocialHandle.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_SocialHandle_RequiresPlatform(t *testing.T) {
+	for _, value := range []string{"user", "@user"} {
+		if result := graphql.Handle.ParseValue(value); result == nil {
+			t.Fatalf("Expected Handle to accept %q", value)
+		}
+		if result := graphql.SocialHandle.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+		if _, ok := graphql.ParseSocialHandle(value); ok {
+			t.Fatalf("Expected ParseSocialHandle to reject %q", value)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const strictDateLayout = "2006-01-02"
+
+func serializeStrictDate(value interface{}) interface{} {
+	switch value := value.(type) {
+	case time.Time:
+		return value.Format(strictDateLayout)
+	case *time.Time:
+		return serializeStrictDate(*value)
+	}
+	return nil
+}
+
+func unserializeStrictDate(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		// time.Parse with a date-only layout already rejects a trailing time,
+		// such as `T00:00:00Z`.
+		t, err := time.ParseInLocation(strictDateLayout, value, time.UTC)
+		if err != nil {
+			return nil
+		}
+		return t
+	case *string:
+		return unserializeStrictDate(*value)
+	}
+	return nil
+}
+
+// StrictDate is the GraphQL calendar date type definition. Parsed values are
+// a time.Time at midnight UTC.
+var StrictDate = NewScalar(ScalarConfig{
+	Name: "StrictDate",
+	Description: "The `StrictDate` scalar type represents a calendar date of the form " +
+		"`YYYY-MM-DD`, without a time of day.",
+	Serialize:  serializeStrictDate,
+	ParseValue: unserializeStrictDate,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeStrictDate(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,27 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_StrictDate_AcceptsDateOnly(t *testing.T) {
+	expected := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	if result := graphql.StrictDate.ParseLiteral(&ast.StringValue{Value: "2023-01-01"}); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := graphql.StrictDate.Serialize(expected); result != "2023-01-01" {
+		t.Fatalf("Expected 2023-01-01, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_StrictDate_RejectsDateTime(t *testing.T) {
+	for _, value := range []string{"2023-01-01T00:00:00Z", "2023-1-1", "2023-02-30"} {
+		if result := graphql.StrictDate.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// subdivisionCodes holds the ISO 3166-2 subdivision codes known to the
+// SubdivisionCode scalar, keyed by country.
+var subdivisionCodes = map[string][]string{
+	"US": {
+		"AL", "AK", "AZ", "AR", "CA", "CO", "CT", "DE", "FL", "GA",
+		"HI", "ID", "IL", "IN", "IA", "KS", "KY", "LA", "ME", "MD",
+		"MA", "MI", "MN", "MS", "MO", "MT", "NE", "NV", "NH", "NJ",
+		"NM", "NY", "NC", "ND", "OH", "OK", "OR", "PA", "RI", "SC",
+		"SD", "TN", "TX", "UT", "VT", "VA", "WA", "WV", "WI", "WY",
+		"DC", "AS", "GU", "MP", "PR", "UM", "VI",
+	},
+	"CA": {
+		"AB", "BC", "MB", "NB", "NL", "NS", "NT", "NU", "ON", "PE",
+		"QC", "SK", "YT",
+	},
+	"GB": {"ENG", "NIR", "SCT", "WLS"},
+	"DE": {
+		"BB", "BE", "BW", "BY", "HB", "HE", "HH", "MV", "NI", "NW",
+		"RP", "SH", "SL", "SN", "ST", "TH",
+	},
+}
+
+var subdivisionCodeSet = func() map[string]bool {
+	set := map[string]bool{}
+	for country, codes := range subdivisionCodes {
+		for _, code := range codes {
+			set[country+"-"+code] = true
+		}
+	}
+	return set
+}()
+
+func coerceSubdivisionCode(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		code := strings.ToUpper(value)
+		if !subdivisionCodeSet[code] {
+			return nil
+		}
+		return code
+	case *string:
+		return coerceSubdivisionCode(*value)
+	}
+	return nil
+}
+
+// SubdivisionCode is the GraphQL ISO 3166-2 subdivision code type definition.
+var SubdivisionCode = NewScalar(ScalarConfig{
+	Name: "SubdivisionCode",
+	Description: "The `SubdivisionCode` scalar type represents an ISO 3166-2 " +
+		"subdivision code such as `US-CA`. Subdivisions of the United States, " +
+		"Canada, the United Kingdom and Germany are supported.",
+	Serialize:  coerceSubdivisionCode,
+	ParseValue: coerceSubdivisionCode,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceSubdivisionCode(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,25 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_SubdivisionCode_UppercasesKnownCode(t *testing.T) {
+	if result := graphql.SubdivisionCode.ParseValue("us-ca"); result != "US-CA" {
+		t.Fatalf("Expected US-CA, got: %v", result)
+	}
+	if result := graphql.SubdivisionCode.ParseLiteral(&ast.StringValue{Value: "GB-SCT"}); result != "GB-SCT" {
+		t.Fatalf("Expected GB-SCT, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_SubdivisionCode_RejectsUnknownCode(t *testing.T) {
+	for _, value := range []string{"US-ZZ", "USCA", "FR-IDF"} {
+		if result := graphql.SubdivisionCode.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var tagRegexp = regexp.MustCompile(`^[a-z0-9]+([-_][a-z0-9]+)*$`)
+
+// coerceTag lowercases value, trims it and joins its words with hyphens,
+// then rejects any tag with characters other than letters, digits,
+// hyphens and underscores.
+func coerceTag(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		tag := strings.Join(strings.Fields(strings.ToLower(value)), "-")
+		if !tagRegexp.MatchString(tag) {
+			return nil
+		}
+		return tag
+	case *string:
+		return coerceTag(*value)
+	}
+	return nil
+}
+
+// Tag is the GraphQL normalized tag type definition.
+var Tag = NewScalar(ScalarConfig{
+	Name: "Tag",
+	Description: "The `Tag` scalar type represents a lowercase, hyphen-separated " +
+		"tag such as `my-tag`. Whitespace in the input is replaced by hyphens.",
+	Serialize:  coerceTag,
+	ParseValue: coerceTag,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceTag(valueAST.Value)
+		}
+		return nil
+	},
+})
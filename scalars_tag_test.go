@@ -0,0 +1,25 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Tag_Normalizes(t *testing.T) {
+	if result := graphql.Tag.ParseValue(" My Tag "); result != "my-tag" {
+		t.Fatalf("Expected my-tag, got: %v", result)
+	}
+	if result := graphql.Tag.ParseLiteral(&ast.StringValue{Value: "Go_Lang  Tips"}); result != "go_lang-tips" {
+		t.Fatalf("Expected go_lang-tips, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Tag_RejectsSpecialCharacters(t *testing.T) {
+	for _, value := range []string{"bad/tag", "#tag", "   ", "-leading"} {
+		if result := graphql.Tag.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
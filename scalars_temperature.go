@@ -0,0 +1,100 @@
+package graphql
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const absoluteZeroCelsius = -273.15
+
+// TemperatureValue is the value of the Temperature scalar. Celsius holds the
+// temperature in degrees Celsius and Unit the unit ("C", "F" or "K") it was
+// given in, which is also the unit it is serialized in.
+type TemperatureValue struct {
+	Celsius float64
+	Unit    string
+}
+
+func toCelsius(value float64, unit string) float64 {
+	switch unit {
+	case "F":
+		return (value - 32) * 5 / 9
+	case "K":
+		return value + absoluteZeroCelsius
+	}
+	return value
+}
+
+func fromCelsius(celsius float64, unit string) float64 {
+	switch unit {
+	case "F":
+		return celsius*9/5 + 32
+	case "K":
+		return celsius - absoluteZeroCelsius
+	}
+	return celsius
+}
+
+func parseTemperature(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if value == "" {
+			return nil
+		}
+		unit := value[len(value)-1:]
+		if unit != "C" && unit != "F" && unit != "K" {
+			return nil
+		}
+		number := strings.TrimSuffix(strings.TrimSpace(value[:len(value)-1]), "°")
+		n, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return nil
+		}
+		return parseTemperature(TemperatureValue{Celsius: toCelsius(n, unit), Unit: unit})
+	case *string:
+		return parseTemperature(*value)
+	case TemperatureValue:
+		// NaN and infinite temperatures cannot be encoded as JSON.
+		if math.IsNaN(value.Celsius) || math.IsInf(value.Celsius, 0) || value.Celsius < absoluteZeroCelsius {
+			return nil
+		}
+		return value
+	case *TemperatureValue:
+		return parseTemperature(*value)
+	}
+	return nil
+}
+
+func serializeTemperature(value interface{}) interface{} {
+	t, ok := parseTemperature(value).(TemperatureValue)
+	if !ok {
+		return nil
+	}
+	n := fromCelsius(t.Celsius, t.Unit)
+	if math.IsInf(n, 0) {
+		return nil
+	}
+	return strconv.FormatFloat(n, 'f', -1, 64) + t.Unit
+}
+
+// Temperature is the GraphQL temperature type definition.
+var Temperature = NewScalar(ScalarConfig{
+	Name: "Temperature",
+	Description: "The `Temperature` scalar type represents a temperature as a number " +
+		"followed by its unit: `C` (Celsius), `F` (Fahrenheit) or `K` (Kelvin), " +
+		"such as `\"20C\"`. Temperatures below absolute zero and non-finite " +
+		"temperatures are rejected.",
+	Serialize:  serializeTemperature,
+	ParseValue: parseTemperature,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return parseTemperature(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,48 @@
+package graphql_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Temperature_NormalizesToCelsius(t *testing.T) {
+	result, ok := graphql.Temperature.ParseValue("68F").(graphql.TemperatureValue)
+	if !ok || math.Abs(result.Celsius-20) > 1e-9 || result.Unit != "F" {
+		t.Fatalf("Expected 20C given in F, got: %+v", result)
+	}
+	result, ok = graphql.Temperature.ParseLiteral(&ast.StringValue{Value: "0K"}).(graphql.TemperatureValue)
+	if !ok || result.Celsius != -273.15 {
+		t.Fatalf("Expected -273.15C, got: %+v", result)
+	}
+	if result := graphql.Temperature.Serialize(graphql.TemperatureValue{Celsius: 20, Unit: "F"}); result != "68F" {
+		t.Fatalf("Expected 68F, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Temperature_RejectsInvalidTemperatures(t *testing.T) {
+	for _, value := range []string{"-300C", "-1K", "20", "20X", "warmC"} {
+		if result := graphql.Temperature.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %+v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Temperature_RejectsNonFiniteTemperatures(t *testing.T) {
+	for _, value := range []string{"NaNC", "InfC", "+InfF", "1e309K"} {
+		if result := graphql.Temperature.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %+v", value, result)
+		}
+	}
+	for _, value := range []interface{}{
+		graphql.TemperatureValue{Celsius: math.NaN(), Unit: "C"},
+		graphql.TemperatureValue{Celsius: math.Inf(1), Unit: "K"},
+		graphql.TemperatureValue{Celsius: math.MaxFloat64, Unit: "F"},
+	} {
+		if result := graphql.Temperature.Serialize(value); result != nil {
+			t.Fatalf("Expected nil for %+v, got: %v", value, result)
+		}
+	}
+}
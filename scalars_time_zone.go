@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func coerceTimeZone(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		// LoadLocation maps "" to UTC and "Local" to the host zone, neither
+		// of which is an IANA name.
+		if value == "" || value == "Local" {
+			return nil
+		}
+		loc, err := time.LoadLocation(value)
+		if err != nil {
+			return nil
+		}
+		return loc.String()
+	case *string:
+		return coerceTimeZone(*value)
+	case *time.Location:
+		return coerceTimeZone(value.String())
+	}
+	return nil
+}
+
+// TimeZone is the GraphQL IANA time zone name type definition.
+var TimeZone = NewScalar(ScalarConfig{
+	Name: "TimeZone",
+	Description: "The `TimeZone` scalar type represents an IANA time zone name, " +
+		"such as `America/New_York`.",
+	Serialize:  coerceTimeZone,
+	ParseValue: coerceTimeZone,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceTimeZone(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,23 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_TimeZone_AcceptsIANANames(t *testing.T) {
+	expected := "America/New_York"
+	if result := graphql.TimeZone.ParseLiteral(&ast.StringValue{Value: expected}); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_TimeZone_RejectsUnknownNames(t *testing.T) {
+	for _, value := range []string{"Mars/Phobos", "", "Local"} {
+		if result := graphql.TimeZone.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
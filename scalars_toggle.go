@@ -0,0 +1,19 @@
+package graphql
+
+var toggleValues = NewMappedBooleanScalar(
+	[]string{"true", "on", "yes", "enabled", "enable", "1"},
+	[]string{"false", "off", "no", "disabled", "disable", "0"},
+	true,
+)
+
+// Toggle is the GraphQL on/off switch type definition. Unlike Boolean, it
+// rejects strings that are not a known synonym of on or off.
+var Toggle = NewScalar(ScalarConfig{
+	Name: "Toggle",
+	Description: "The `Toggle` scalar type represents `true` or `false`, also " +
+		"accepting case-insensitive synonyms such as `\"on\"`/`\"off\"` and " +
+		"`\"enabled\"`/`\"disabled\"`.",
+	Serialize:    toggleValues.Serialize,
+	ParseValue:   toggleValues.ParseValue,
+	ParseLiteral: toggleValues.ParseLiteral,
+})
@@ -0,0 +1,26 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Toggle_MapsSynonyms(t *testing.T) {
+	if result := graphql.Toggle.ParseLiteral(&ast.StringValue{Value: "Enabled"}); result != true {
+		t.Fatalf("Expected true, got: %v", result)
+	}
+	if result := graphql.Toggle.ParseValue("off"); result != false {
+		t.Fatalf("Expected false, got: %v", result)
+	}
+	if result := graphql.Toggle.ParseLiteral(&ast.BooleanValue{Value: true}); result != true {
+		t.Fatalf("Expected true, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Toggle_RejectsUnknownValues(t *testing.T) {
+	if result := graphql.Toggle.ParseValue("sometimes"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
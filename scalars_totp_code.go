@@ -0,0 +1,77 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// NewTOTPCodeScalar creates a scalar for one-time passwords of the given
+// lengths in digits, which must be 6 or 8. Codes are kept as strings so
+// leading zeros are preserved. The scalar is named `TOTPCode` when it allows
+// both lengths, and `TOTPCode6` or `TOTPCode8` otherwise.
+func NewTOTPCodeScalar(lengths ...int) *Scalar {
+	allowed := map[int]bool{}
+	names := []string{}
+	for _, length := range lengths {
+		if length != 6 && length != 8 {
+			return &Scalar{
+				err: gqlerrors.NewFormattedError(fmt.Sprintf(`TOTP codes of length %v are not supported.`, length)),
+			}
+		}
+		allowed[length] = true
+		names = append(names, fmt.Sprint(length))
+	}
+	if len(allowed) == 0 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError("TOTP code length must be specified."),
+		}
+	}
+	name := "TOTPCode"
+	if len(allowed) == 1 {
+		name += names[0]
+	}
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if !allowed[len(value)] || !isDigits(value) {
+				return nil
+			}
+			return value
+		case *string:
+			return coerce(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: fmt.Sprintf("The `%v` scalar type represents a time-based "+
+			"one-time password of %v digits.", name, strings.Join(names, " or ")),
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+		RedactInErrors: true,
+	})
+}
+
+// TOTPCode is the GraphQL time-based one-time password type definition,
+// accepting codes of 6 or 8 digits.
+var TOTPCode = NewTOTPCodeScalar(6, 8)
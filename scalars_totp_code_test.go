@@ -0,0 +1,44 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_TOTPCode_PreservesLeadingZeros(t *testing.T) {
+	if result := graphql.TOTPCode.ParseLiteral(&ast.StringValue{Value: "012345"}); result != "012345" {
+		t.Fatalf("Expected 012345, got: %v", result)
+	}
+	if result := graphql.TOTPCode.ParseValue("01234567"); result != "01234567" {
+		t.Fatalf("Expected 01234567, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_TOTPCode_RejectsInvalidCodes(t *testing.T) {
+	sixDigits := graphql.NewTOTPCodeScalar(6)
+	for _, value := range []string{"12345", "01234567", "12345a"} {
+		if result := sixDigits.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+	if err := graphql.NewTOTPCodeScalar(7).Error(); err == nil {
+		t.Fatalf("Expected an error for an unsupported length")
+	}
+}
+
+func TestTypeSystem_Scalar_TOTPCode_RedactsCodeInErrors(t *testing.T) {
+	assertRedactedInErrors(t, graphql.TOTPCode, "1234567")
+}
+
+func TestTypeSystem_Scalar_TOTPCode_NamedAfterLength(t *testing.T) {
+	sixDigits := graphql.NewTOTPCodeScalar(6)
+	if sixDigits.Name() != "TOTPCode6" {
+		t.Fatalf("Expected TOTPCode6, got: %v", sixDigits.Name())
+	}
+	if name := graphql.NewTOTPCodeScalar(8, 8).Name(); name != "TOTPCode8" {
+		t.Fatalf("Expected TOTPCode8, got: %v", name)
+	}
+	assertScalarsCoexist(t, graphql.TOTPCode, sixDigits, graphql.NewTOTPCodeScalar(8))
+}
@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// TristateValue is the Go representation of a Tristate scalar value.
+type TristateValue int
+
+// Tristate values. TristateUnknown is an explicit state and is distinct
+// from a null value.
+const (
+	TristateUnknown TristateValue = iota
+	TristateNo
+	TristateYes
+)
+
+var tristateNames = map[TristateValue]string{
+	TristateUnknown: "unknown",
+	TristateNo:      "no",
+	TristateYes:     "yes",
+}
+
+// String returns "yes", "no" or "unknown".
+func (v TristateValue) String() string {
+	return tristateNames[v]
+}
+
+func parseTristate(value interface{}) (TristateValue, bool) {
+	switch value := value.(type) {
+	case TristateValue:
+		_, ok := tristateNames[value]
+		return value, ok
+	case *TristateValue:
+		return parseTristate(*value)
+	case bool:
+		if value {
+			return TristateYes, true
+		}
+		return TristateNo, true
+	case *bool:
+		return parseTristate(*value)
+	case string:
+		name := strings.ToLower(value)
+		for v, n := range tristateNames {
+			if name == n {
+				return v, true
+			}
+		}
+	case *string:
+		return parseTristate(*value)
+	}
+	return 0, false
+}
+
+func serializeTristate(value interface{}) interface{} {
+	if v, ok := parseTristate(value); ok {
+		return v.String()
+	}
+	return nil
+}
+
+func unserializeTristate(value interface{}) interface{} {
+	if v, ok := parseTristate(value); ok {
+		return v
+	}
+	return nil
+}
+
+// Tristate is the GraphQL yes, no or unknown type definition.
+var Tristate = NewScalar(ScalarConfig{
+	Name: "Tristate",
+	Description: "The `Tristate` scalar type represents `yes`, `no` or an explicit " +
+		"`unknown`, which is distinct from null. Input also accepts `true` and " +
+		"`false`.",
+	Serialize:  serializeTristate,
+	ParseValue: unserializeTristate,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeTristate(valueAST.Value)
+		case *ast.BooleanValue:
+			return unserializeTristate(valueAST.Value)
+		}
+		return nil
+	},
+})
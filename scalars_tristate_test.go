@@ -0,0 +1,43 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Tristate_ParsesStatesDistinctly(t *testing.T) {
+	for value, expected := range map[string]graphql.TristateValue{
+		"yes":     graphql.TristateYes,
+		"no":      graphql.TristateNo,
+		"Unknown": graphql.TristateUnknown,
+	} {
+		if result := graphql.Tristate.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+	if result := graphql.Tristate.ParseLiteral(&ast.BooleanValue{Value: true}); result != graphql.TristateYes {
+		t.Fatalf("Expected %v, got: %v", graphql.TristateYes, result)
+	}
+	if result := graphql.Tristate.ParseValue(false); result != graphql.TristateNo {
+		t.Fatalf("Expected %v, got: %v", graphql.TristateNo, result)
+	}
+}
+
+func TestTypeSystem_Scalar_Tristate_SerializesNames(t *testing.T) {
+	if result := graphql.Tristate.Serialize(graphql.TristateUnknown); result != "unknown" {
+		t.Fatalf("Expected %v, got: %v", "unknown", result)
+	}
+	if result := graphql.Tristate.Serialize(nil); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Tristate_RejectsInvalidValues(t *testing.T) {
+	for _, value := range []interface{}{"maybe", "", 1} {
+		if result := graphql.Tristate.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %v, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package graphql
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func parseUnicodeNFC(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if !utf8.ValidString(value) {
+			return nil
+		}
+		return norm.NFC.String(value)
+	case *string:
+		return parseUnicodeNFC(*value)
+	}
+	return nil
+}
+
+// UnicodeNFC is the GraphQL NFC-normalized Unicode text type definition.
+var UnicodeNFC = NewScalar(ScalarConfig{
+	Name: "UnicodeNFC",
+	Description: "The `UnicodeNFC` scalar type represents well-formed UTF-8 text, " +
+		"normalized to Unicode normalization form C on input.",
+	Serialize:  coerceString,
+	ParseValue: parseUnicodeNFC,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return parseUnicodeNFC(valueAST.Value)
+		}
+		return nil
+	},
+})
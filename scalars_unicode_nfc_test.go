@@ -0,0 +1,43 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_UnicodeNFC_ComposesDecomposedText(t *testing.T) {
+	decomposed := "cafe\u0301"
+	result, ok := graphql.UnicodeNFC.ParseLiteral(&ast.StringValue{Value: decomposed}).(string)
+	if !ok || result != "caf\u00e9" {
+		t.Fatalf("Expected %q, got: %q", "caf\u00e9", result)
+	}
+	if len(decomposed) != 6 || len(result) != 5 {
+		t.Fatalf("Expected byte length to change from 6 to 5, got: %v to %v", len(decomposed), len(result))
+	}
+	if result := graphql.UnicodeNFC.ParseValue("caf\u00e9"); result != "caf\u00e9" {
+		t.Fatalf("Expected composed text to be unchanged, got: %q", result)
+	}
+}
+
+func TestTypeSystem_Scalar_UnicodeNFC_NormalizesBeyondLatin(t *testing.T) {
+	tests := map[string]string{
+		// Hangul jamo compose into a syllable.
+		"\u1100\u1161": "\uac00",
+		// Combining marks are put in canonical order before composing.
+		"a\u0302\u0323": "\u1ead",
+		"a\u0323\u0302": "\u1ead",
+	}
+	for value, expected := range tests {
+		if result := graphql.UnicodeNFC.ParseValue(value); result != expected {
+			t.Fatalf("Expected %q for %q, got: %q", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_UnicodeNFC_RejectsMalformedUTF8(t *testing.T) {
+	if result := graphql.UnicodeNFC.ParseValue("caf\xe9"); result != nil {
+		t.Fatalf("Expected nil, got: %q", result)
+	}
+}
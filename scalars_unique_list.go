@@ -0,0 +1,27 @@
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// UniqueList creates a scalar for lists of distinct values of s. Elements
+// are compared after s has coerced them, so with a case-insensitive s, `"a"`
+// and `"A"` are duplicates. The scalar is named after s, such as
+// `UniqueStringList`.
+func UniqueList(s *Scalar) *Scalar {
+	return newListScalar(s, "Unique"+s.Name()+"List",
+		fmt.Sprintf("The `Unique%vList` scalar type represents a list of distinct `%v` values.",
+			s.Name(), s.Name()),
+		func(items []interface{}) bool {
+			for i := range items {
+				for j := 0; j < i; j++ {
+					if reflect.DeepEqual(items[i], items[j]) {
+						return false
+					}
+				}
+			}
+			return true
+		},
+	)
+}
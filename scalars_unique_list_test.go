@@ -0,0 +1,26 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+)
+
+func TestTypeSystem_Scalar_UniqueList_AcceptsDistinctValues(t *testing.T) {
+	tags := graphql.UniqueList(graphql.String)
+	expected := []interface{}{"a", "b"}
+	if result := tags.ParseLiteral(stringListValue("a", "b")); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_UniqueList_RejectsDuplicates(t *testing.T) {
+	if result := graphql.UniqueList(graphql.String).ParseLiteral(stringListValue("a", "b", "a")); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	hashtags := graphql.UniqueList(graphql.Hashtag)
+	if result := hashtags.ParseValue([]interface{}{"#Go", "go"}); result != nil {
+		t.Fatalf("Expected nil for values equal after coercion, got: %v", result)
+	}
+}
@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NewURLScalar creates a URL scalar that accepts relative references,
+// absolute URLs, or both. A relative reference has neither a scheme nor a
+// host, which also rules out protocol-relative `//host/path` references; an
+// absolute URL has both. Backslashes in strings are read as slashes, as
+// browsers do, so that `/\host/path` is rejected as protocol-relative too.
+// The scalar is named `URL` when only absolute URLs are allowed,
+// `RelativeURL` when only relative references are allowed and `URLReference`
+// when both are.
+func NewURLScalar(allowRelative, allowAbsolute bool) *Scalar {
+	name := "URLReference"
+	switch {
+	case !allowRelative && !allowAbsolute:
+		return &Scalar{
+			err: gqlerrors.NewFormattedError("URL scalar must allow relative or absolute URLs."),
+		}
+	case !allowRelative:
+		name = "URL"
+	case !allowAbsolute:
+		name = "RelativeURL"
+	}
+
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			if value == "" {
+				return nil
+			}
+			u, err := url.Parse(strings.Replace(value, `\`, "/", -1))
+			if err != nil {
+				return nil
+			}
+			return coerce(u)
+		case *string:
+			return coerce(*value)
+		case url.URL:
+			return coerce(&value)
+		case *url.URL:
+			switch {
+			case value.Scheme == "" && value.Host == "":
+				if !allowRelative || strings.HasPrefix(value.Path, "//") {
+					return nil
+				}
+			case value.Scheme != "" && value.Host != "":
+				if !allowAbsolute {
+					return nil
+				}
+			default:
+				return nil
+			}
+			return value.String()
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name: name,
+		Description: "The `" + name + "` scalar type represents a URL as specified by " +
+			"[RFC 3986](https://tools.ietf.org/html/rfc3986).",
+		Serialize:  coerce,
+		ParseValue: coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
+
+// URL is the GraphQL absolute URL type definition.
+var URL = NewURLScalar(false, true)
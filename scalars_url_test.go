@@ -0,0 +1,56 @@
+package graphql_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_URL_AcceptsAbsoluteURL(t *testing.T) {
+	expected := "https://example.com/path?q=1"
+	if result := graphql.URL.ParseValue(expected); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	u, _ := url.Parse(expected)
+	if result := graphql.URL.Serialize(u); result != expected {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+	if result := graphql.URL.ParseValue("/next"); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_URL_RelativeOnly(t *testing.T) {
+	relativeURL := graphql.NewURLScalar(true, false)
+	if result := relativeURL.ParseLiteral(&ast.StringValue{Value: "/next"}); result != "/next" {
+		t.Fatalf("Expected /next, got: %v", result)
+	}
+	for _, value := range []string{"https://evil.com", "//evil.com/next", "javascript:alert(1)"} {
+		if result := relativeURL.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_URL_RelativeOnlyRejectsProtocolRelativeBackslashes(t *testing.T) {
+	relativeURL := graphql.NewURLScalar(true, false)
+	for _, value := range []string{`/\evil.com`, `\\evil.com`, `\/evil.com/next`} {
+		if result := relativeURL.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+	if result := relativeURL.Serialize(&url.URL{Path: "//evil.com"}); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if result := relativeURL.ParseValue(`/next\page`); result != "/next/page" {
+		t.Fatalf("Expected /next/page, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_URL_MustAllowSomething(t *testing.T) {
+	if graphql.NewURLScalar(false, false).Error() == nil {
+		t.Fatalf("Expected an error when neither relative nor absolute URLs are allowed")
+	}
+}
@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var usernameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]{2,19}$`)
+
+func coerceUsername(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		username := strings.ToLower(value)
+		if !usernameRegexp.MatchString(username) {
+			return nil
+		}
+		return username
+	case *string:
+		return coerceUsername(*value)
+	}
+	return nil
+}
+
+// Username is the GraphQL username type definition.
+var Username = NewScalar(ScalarConfig{
+	Name: "Username",
+	Description: "The `Username` scalar type represents a lowercase username of 3 to " +
+		"20 letters, digits and underscores that does not start with a digit.",
+	Serialize:  coerceUsername,
+	ParseValue: coerceUsername,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceUsername(valueAST.Value)
+		}
+		return nil
+	},
+})
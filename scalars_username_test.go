@@ -0,0 +1,22 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Username_Lowercases(t *testing.T) {
+	if result := graphql.Username.ParseLiteral(&ast.StringValue{Value: "User_1"}); result != "user_1" {
+		t.Fatalf("Expected user_1, got: %v", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Username_RejectsInvalidNames(t *testing.T) {
+	for _, value := range []string{"ab", "1abc", "abcdefghijklmnopqrstu", "a-b-c"} {
+		if result := graphql.Username.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// NewUUIDScalar creates a scalar for UUIDs in their canonical hyphenated form.
+// A version of 0 accepts any UUID; versions 1 to 8 only accept UUIDs with
+// that version. Values are normalized to lowercase.
+func NewUUIDScalar(version int) *Scalar {
+	if version < 0 || version > 8 {
+		return &Scalar{
+			err: gqlerrors.NewFormattedError(fmt.Sprintf(`UUID version %v is not supported.`, version)),
+		}
+	}
+	name := "UUID"
+	description := "The `UUID` scalar type represents a UUID in its canonical " +
+		"hyphenated form."
+	if version != 0 {
+		name = fmt.Sprintf("UUIDv%v", version)
+		description = fmt.Sprintf("The `%v` scalar type represents a version %v "+
+			"UUID in its canonical hyphenated form.", name, version)
+	}
+	var coerce func(value interface{}) interface{}
+	coerce = func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case string:
+			uuid := strings.ToLower(value)
+			if !uuidRegexp.MatchString(uuid) {
+				return nil
+			}
+			// The version is the first hex digit of the third group.
+			if version != 0 && uuid[14] != byte('0'+version) {
+				return nil
+			}
+			return uuid
+		case *string:
+			return coerce(*value)
+		}
+		return nil
+	}
+	return NewScalar(ScalarConfig{
+		Name:        name,
+		Description: description,
+		Serialize:   coerce,
+		ParseValue:  coerce,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue:
+				return coerce(valueAST.Value)
+			}
+			return nil
+		},
+	})
+}
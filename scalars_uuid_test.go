@@ -0,0 +1,38 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	uuidV1 = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	uuidV4 = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+)
+
+func TestTypeSystem_Scalar_UUID_AcceptsAnyVersion(t *testing.T) {
+	uuid := graphql.NewUUIDScalar(0)
+	for _, value := range []string{uuidV1, uuidV4} {
+		if result := uuid.ParseLiteral(&ast.StringValue{Value: value}); result != value {
+			t.Fatalf("Expected %v, got: %v", value, result)
+		}
+	}
+	if result := uuid.ParseValue("F47AC10B-58CC-4372-A567-0E02B2C3D479"); result != uuidV4 {
+		t.Fatalf("Expected %v, got: %v", uuidV4, result)
+	}
+}
+
+func TestTypeSystem_Scalar_UUID_RestrictsVersion(t *testing.T) {
+	uuid := graphql.NewUUIDScalar(4)
+	if result := uuid.ParseValue(uuidV4); result != uuidV4 {
+		t.Fatalf("Expected %v, got: %v", uuidV4, result)
+	}
+	if result := uuid.ParseValue(uuidV1); result != nil {
+		t.Fatalf("Expected nil, got: %v", result)
+	}
+	if name := uuid.Name(); name != "UUIDv4" {
+		t.Fatalf("Expected UUIDv4, got: %v", name)
+	}
+}
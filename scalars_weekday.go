@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// parseWeekday maps a full or three-letter weekday name, in any case, to
+// its time.Weekday.
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(name)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+func serializeWeekday(value interface{}) interface{} {
+	switch value := value.(type) {
+	case time.Weekday:
+		if value < time.Sunday || value > time.Saturday {
+			return nil
+		}
+		return value.String()
+	case *time.Weekday:
+		return serializeWeekday(*value)
+	case string:
+		if d, ok := parseWeekday(value); ok {
+			return d.String()
+		}
+	case *string:
+		return serializeWeekday(*value)
+	}
+	return nil
+}
+
+func unserializeWeekday(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if d, ok := parseWeekday(value); ok {
+			return d
+		}
+	case *string:
+		return unserializeWeekday(*value)
+	}
+	return nil
+}
+
+// Weekday is the GraphQL day of the week type definition.
+var Weekday = NewScalar(ScalarConfig{
+	Name: "Weekday",
+	Description: "The `Weekday` scalar type represents a day of the week. Input " +
+		"accepts full or three-letter names in any case, such as `monday` or " +
+		"`Mon`; output is the full name, such as `Monday`.",
+	Serialize:  serializeWeekday,
+	ParseValue: unserializeWeekday,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeWeekday(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -0,0 +1,35 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestTypeSystem_Scalar_Weekday_ParsesNames(t *testing.T) {
+	for value, expected := range map[string]time.Weekday{
+		"tue":    time.Tuesday,
+		"Mon":    time.Monday,
+		"SUNDAY": time.Sunday,
+	} {
+		if result := graphql.Weekday.ParseLiteral(&ast.StringValue{Value: value}); result != expected {
+			t.Fatalf("Expected %v for %q, got: %v", expected, value, result)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_Weekday_SerializesFullName(t *testing.T) {
+	if result := graphql.Weekday.Serialize(time.Tuesday); result != "Tuesday" {
+		t.Fatalf("Expected %v, got: %v", "Tuesday", result)
+	}
+}
+
+func TestTypeSystem_Scalar_Weekday_RejectsUnknownNames(t *testing.T) {
+	for _, value := range []string{"funday", "tu", ""} {
+		if result := graphql.Weekday.ParseValue(value); result != nil {
+			t.Fatalf("Expected nil for %q, got: %v", value, result)
+		}
+	}
+}
@@ -96,6 +96,8 @@ type ValidationContext struct {
 	recursiveVariableUsages        map[*ast.OperationDefinition][]*VariableUsage
 	recursivelyReferencedFragments map[*ast.OperationDefinition][]*ast.FragmentDefinition
 	fragmentSpreads                map[*ast.SelectionSet][]*ast.FragmentSpread
+	invalidValueCount              int
+	tooManyInvalidValues           bool
 }
 
 func NewValidationContext(schema *Schema, astDoc *ast.Document, typeInfo *TypeInfo) *ValidationContext {
@@ -1,7 +1,10 @@
 package graphql_test
 
 import (
+	"bytes"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/graphql-go/graphql"
@@ -96,3 +99,132 @@ func TestValidator_SupportsFullValidation_ValidatesUsingACustomTypeInfo(t *testi
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, errors))
 	}
 }
+
+func maxScalarErrorsTestQuery(badArguments int) string {
+	query := bytes.Buffer{}
+	query.WriteString(`query ($a: Int = "one", $b: Int = "two") {`)
+	for i := 0; i < badArguments; i++ {
+		fmt.Fprintf(&query, ` f%v: echo(n: "not an int")`, i)
+	}
+	query.WriteString(` a: echo(n: $a) b: echo(n: $b) }`)
+	return query.String()
+}
+
+func maxScalarErrorsTestSchema(t *testing.T) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"echo": &graphql.Field{
+					Type: graphql.Int,
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: graphql.Int},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Args["n"], nil
+					},
+				},
+				"echoList": &graphql.Field{
+					Type: graphql.NewList(graphql.Int),
+					Args: graphql.FieldConfigArgument{
+						"n": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.Int)},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Args["n"], nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	return schema
+}
+
+func TestValidator_SetMaxScalarErrors_LimitsInvalidValueErrorsOfARequest(t *testing.T) {
+	schema := maxScalarErrorsTestSchema(t)
+	query := maxScalarErrorsTestQuery(98)
+
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	if len(result.Errors) != 100 {
+		t.Fatalf("Expected 100 errors without a limit, got: %v", len(result.Errors))
+	}
+
+	graphql.SetMaxScalarErrors(10)
+	defer graphql.SetMaxScalarErrors(0)
+
+	result = graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	if len(result.Errors) != 11 {
+		t.Fatalf("Expected 11 errors, got: %v", len(result.Errors))
+	}
+	// The two variable defaults are validated first, so the limit is
+	// exceeded at the argument of f8.
+	expected := gqlerrors.FormattedError{
+		Message: `Too many invalid values, only the first 10 are reported.`,
+		Locations: []location.SourceLocation{
+			{Line: 1, Column: strings.Index(query, ` f8: echo(n: `) + len(` f8: echo(n: `) + 1},
+		},
+	}
+	if !reflect.DeepEqual(expected, result.Errors[10]) {
+		t.Fatalf("Unexpected error, Diff: %v", testutil.Diff(expected, result.Errors[10]))
+	}
+
+	result = graphql.Do(graphql.Params{Schema: schema, RequestString: maxScalarErrorsTestQuery(8)})
+	if len(result.Errors) != 10 {
+		t.Fatalf("Expected 10 errors at the limit, got: %v", len(result.Errors))
+	}
+}
+
+func TestValidator_SetMaxScalarErrors_LimitsInvalidElementsOfAList(t *testing.T) {
+	schema := maxScalarErrorsTestSchema(t)
+	elements := make([]string, 100)
+	for i := range elements {
+		elements[i] = `"not an int"`
+	}
+	query := `{ echoList(n: [` + strings.Join(elements, ", ") + `]) }`
+
+	graphql.SetMaxScalarErrors(10)
+	defer graphql.SetMaxScalarErrors(0)
+
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", result.Errors)
+	}
+	if lines := strings.Split(result.Errors[0].Message, "\n"); len(lines) != 11 {
+		t.Fatalf("Expected the argument error and 10 element messages, got: %v", lines)
+	}
+	expected := gqlerrors.FormattedError{
+		Message: `Too many invalid values, only the first 10 are reported.`,
+		Locations: []location.SourceLocation{
+			{Line: 1, Column: 15},
+		},
+	}
+	if !reflect.DeepEqual(expected, result.Errors[1]) {
+		t.Fatalf("Unexpected error, Diff: %v", testutil.Diff(expected, result.Errors[1]))
+	}
+}
+
+func TestValidator_SetMaxScalarErrors_LimitsInvalidElementsOfAListVariable(t *testing.T) {
+	schema := maxScalarErrorsTestSchema(t)
+	elements := make([]interface{}, 100)
+	for i := range elements {
+		elements[i] = "not an int"
+	}
+
+	graphql.SetMaxScalarErrors(10)
+	defer graphql.SetMaxScalarErrors(0)
+
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($n: [Int]) { echoList(n: $n) }`,
+		VariableValues: map[string]interface{}{"n": elements},
+	})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got: %v", result.Errors)
+	}
+	lines := strings.Split(result.Errors[0].Message, "\n")
+	if len(lines) != 12 || lines[11] != `Too many invalid values, only the first 10 are reported.` {
+		t.Fatalf("Expected 10 element messages followed by a note, got: %v", lines)
+	}
+}
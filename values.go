@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/graphql-go/graphql/gqlerrors"
@@ -24,15 +25,34 @@ func getVariableValues(schema Schema, definitionASTs []*ast.VariableDefinition,
 			continue
 		}
 		varName := defAST.Variable.Name.Value
-		varValue, err := getVariableValue(schema, defAST, inputs[varName])
+		input, provided := inputs[varName]
+		varValue, err := getVariableValue(schema, defAST, input)
 		if err != nil {
 			return values, err
 		}
+		if provided && isNullish(input) {
+			varValue = parseExplicitNull(schema, defAST, varValue)
+		}
 		values[varName] = varValue
 	}
 	return values, nil
 }
 
+// parseExplicitNull returns the value of a variable that was provided as
+// null. Scalars that opt in, such as the optional scalars, parse the null
+// into a value of their own so that resolvers can tell it apart from an
+// omitted variable; for any other type value is returned unchanged.
+func parseExplicitNull(schema Schema, definitionAST *ast.VariableDefinition, value interface{}) interface{} {
+	ttype, err := typeFromAST(schema, definitionAST.Type)
+	if err != nil {
+		return value
+	}
+	if scalar, ok := ttype.(*Scalar); ok && scalar.parsesNull {
+		return scalar.ParseValue(nil)
+	}
+	return value
+}
+
 // Prepares an object map of argument values given a list of argument
 // definitions and list of argument AST nodes.
 func getArgumentValues(argDefs []*Argument, argASTs []*ast.Argument, variableVariables map[string]interface{}) (map[string]interface{}, error) {
@@ -62,6 +82,25 @@ func getArgumentValues(argDefs []*Argument, argASTs []*ast.Argument, variableVar
 	return results, nil
 }
 
+var maxScalarErrors = 0
+
+// SetMaxScalarErrors limits the number of invalid values reported for a
+// request to n, counting each invalid element of a list separately. Once the
+// limit is exceeded, the remaining values are not checked and a single error,
+// or for a variable a single message, noting that further values were omitted
+// is reported instead. A limit of 0, the default, reports every invalid value.
+func SetMaxScalarErrors(n int) {
+	maxScalarErrors = n
+}
+
+// scalarErrorsExceeded reports whether more messages were collected than the
+// limit set by SetMaxScalarErrors, in which case callers stop checking
+// further values. The excess message lets reporters tell that the limit was
+// exceeded.
+func scalarErrorsExceeded(messages []string) bool {
+	return maxScalarErrors > 0 && len(messages) > maxScalarErrors
+}
+
 // Given a variable definition, and any value of input, return a value which
 // adheres to the variable definition, or throw an error.
 func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, input interface{}) (interface{}, error) {
@@ -107,16 +146,33 @@ func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, inpu
 		)
 	}
 	// convert input interface into string for error message
-	inputStr := ""
-	b, err := json.Marshal(input)
-	if err == nil {
-		inputStr = string(b)
+	inputStr := "<redacted>"
+	if !redactsInErrors(ttype) {
+		inputStr = ""
+		b, err := json.Marshal(input)
+		if err == nil {
+			inputStr = string(b)
+		}
+	}
+	if scalarErrorsExceeded(messages) {
+		messages = append(messages[:maxScalarErrors:maxScalarErrors],
+			fmt.Sprintf(`Too many invalid values, only the first %v are reported.`, maxScalarErrors))
 	}
 	messagesStr := ""
 	if len(messages) > 0 {
 		messagesStr = "\n" + strings.Join(messages, "\n")
 	}
 
+	// A value a scalar failed to parse is also reported on its own, as the
+	// original error.
+	var origError error
+	if scalar, ok := GetNullable(ttype).(*Scalar); ok {
+		var value interface{} = input
+		if scalar.scalarConfig.RedactInErrors {
+			value = redactedValue{}
+		}
+		origError = newInvalidValueError(scalar.Name(), value, definitionAST)
+	}
 	return "", gqlerrors.NewError(
 		fmt.Sprintf(`Variable "$%v" got invalid value `+
 			`%v.%v`, variable.Name.Value, inputStr, messagesStr),
@@ -124,7 +180,7 @@ func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, inpu
 		"",
 		nil,
 		[]int{},
-		nil,
+		origError,
 	)
 }
 
@@ -228,6 +284,11 @@ func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 			}
 			return false, []string{"Expected non-null value, found null."}
 		}
+		// Values that a NullableScalar would turn into null are invalid
+		// where null is not allowed.
+		if scalar, ok := ttype.OfType.(*Scalar); ok && scalar.nullOnInvalid && isNullish(scalar.ParseValue(value)) {
+			return false, []string{invalidValueMessage(scalar, value)}
+		}
 		return isValidInputValue(value, ttype.OfType)
 	}
 
@@ -250,6 +311,9 @@ func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 				for idx, message := range messages {
 					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, idx+1, message))
 				}
+				if scalarErrorsExceeded(messagesReduce) {
+					break
+				}
 			}
 			return (len(messagesReduce) == 0), messagesReduce
 		}
@@ -300,21 +364,92 @@ func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 	switch ttype := ttype.(type) {
 	case *Scalar:
 		parsedVal := ttype.ParseValue(value)
-		if isNullish(parsedVal) {
-			return false, []string{fmt.Sprintf(`Expected type "%v", found "%v".`, ttype.Name(), value)}
+		if isNullish(parsedVal) && !ttype.nullOnInvalid {
+			return false, []string{invalidValueMessage(ttype, value)}
 		}
 		return true, nil
 
 	case *Enum:
 		parsedVal := ttype.ParseValue(value)
 		if isNullish(parsedVal) {
-			return false, []string{fmt.Sprintf(`Expected type "%v", found "%v".`, ttype.Name(), value)}
+			return false, []string{invalidValueMessage(ttype, value)}
 		}
 		return true, nil
 	}
 	return true, nil
 }
 
+// redactedValue stands in for a value left out of an error message.
+type redactedValue struct{}
+
+// invalidValueMessage describes a value rejected by the input type ttype,
+// leaving out values of scalars configured with RedactInErrors.
+func invalidValueMessage(ttype Input, value interface{}) string {
+	if scalar, ok := ttype.(*Scalar); ok && scalar.scalarConfig.RedactInErrors {
+		value = redactedValue{}
+	}
+	return invalidValueText(ttype.Name(), value)
+}
+
+// invalidValueText describes a value rejected by the named input type.
+// Literals are printed in GraphQL syntax and runtime values are quoted.
+func invalidValueText(typeName string, value interface{}) string {
+	switch value := value.(type) {
+	case redactedValue:
+		return fmt.Sprintf(`Expected type "%v", found <redacted>.`, typeName)
+	case ast.Value:
+		return fmt.Sprintf(`Expected type "%v", found %v.`, typeName, printer.Print(value))
+	}
+	return fmt.Sprintf(`Expected type "%v", found "%v".`, typeName, value)
+}
+
+// newInvalidValueError creates an error located at node for a value the
+// named scalar failed to parse.
+func newInvalidValueError(scalarName string, value interface{}, node ast.Node) *gqlerrors.Error {
+	return gqlerrors.NewError(
+		invalidValueText(scalarName, value),
+		[]ast.Node{node},
+		"",
+		nil,
+		[]int{},
+		nil,
+	)
+}
+
+// redactsInErrors reports whether values of ttype may hold values of a
+// scalar configured with RedactInErrors, in which case they must not be
+// printed in error messages.
+func redactsInErrors(ttype Type) bool {
+	return redactsInErrorsVisited(ttype, map[*InputObject]bool{})
+}
+
+func redactsInErrorsVisited(ttype Type, visited map[*InputObject]bool) bool {
+	switch ttype := GetNamed(ttype).(type) {
+	case *Scalar:
+		return ttype.scalarConfig.RedactInErrors
+	case *InputObject:
+		if visited[ttype] {
+			return false
+		}
+		visited[ttype] = true
+		for _, field := range ttype.Fields() {
+			if redactsInErrorsVisited(field.Type, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// printValueForError prints valueAST of type ttype for an error message,
+// unless it may hold redacted values.
+func printValueForError(ttype Type, valueAST ast.Value) interface{} {
+	if redactsInErrors(ttype) {
+		return "<redacted>"
+	}
+	return printer.Print(valueAST)
+}
+
 // Returns true if a value is null, undefined, or NaN.
 func isNullish(value interface{}) bool {
 	if value, ok := value.(*string); ok {
@@ -461,6 +596,55 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]interfac
 	return nil
 }
 
+// jsonValueFromAST produces the untyped JSON-like value of a literal: lists
+// become `[]interface{}`, objects `map[string]interface{}`, and scalars their
+// Go counterpart. Variables cannot be resolved and are rejected.
+func jsonValueFromAST(valueAST ast.Value) (interface{}, bool) {
+	switch valueAST := valueAST.(type) {
+	case *ast.StringValue:
+		return valueAST.Value, true
+	case *ast.EnumValue:
+		return valueAST.Value, true
+	case *ast.BooleanValue:
+		return valueAST.Value, true
+	case *ast.IntValue:
+		if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
+			return intValue, true
+		}
+		if floatValue, err := strconv.ParseFloat(valueAST.Value, 64); err == nil {
+			return floatValue, true
+		}
+	case *ast.FloatValue:
+		if floatValue, err := strconv.ParseFloat(valueAST.Value, 64); err == nil {
+			return floatValue, true
+		}
+	case *ast.ListValue:
+		values := []interface{}{}
+		for _, itemAST := range valueAST.Values {
+			v, ok := jsonValueFromAST(itemAST)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, v)
+		}
+		return values, true
+	case *ast.ObjectValue:
+		obj := map[string]interface{}{}
+		for _, fieldAST := range valueAST.Fields {
+			if fieldAST.Name == nil {
+				return nil, false
+			}
+			v, ok := jsonValueFromAST(fieldAST.Value)
+			if !ok {
+				return nil, false
+			}
+			obj[fieldAST.Name.Value] = v
+		}
+		return obj, true
+	}
+	return nil, false
+}
+
 func invariant(condition bool, message string) error {
 	if !condition {
 		return gqlerrors.NewFormattedError(message)
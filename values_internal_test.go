@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func parseOperation(t *testing.T, query string) *ast.OperationDefinition {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	return doc.Definitions[0].(*ast.OperationDefinition)
+}
+
+func TestNewInvalidValueError_NamesScalarAndValue(t *testing.T) {
+	operation := parseOperation(t, `{ before(at: "not-a-date") }`)
+	field := operation.SelectionSet.Selections[0].(*ast.Field)
+	value := field.Arguments[0].Value
+
+	err := newInvalidValueError("DateTime", value, value)
+	expected := `Expected type "DateTime", found "not-a-date".`
+	if err.Message != expected {
+		t.Fatalf("Expected %q, got: %q", expected, err.Message)
+	}
+	if locations := []location.SourceLocation{{Line: 1, Column: 14}}; !reflect.DeepEqual(locations, err.Locations) {
+		t.Fatalf("Expected %v, got: %v", locations, err.Locations)
+	}
+}
+
+func TestGetVariableValues_ReportsUnparsableScalarAsOriginalError(t *testing.T) {
+	schema, err := NewSchema(SchemaConfig{
+		Query: NewObject(ObjectConfig{
+			Name: "Query",
+			Fields: Fields{
+				"now": &Field{Type: DateTime},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	operation := parseOperation(t, `query ($at: DateTime) { now }`)
+
+	_, err = getVariableValues(schema, operation.VariableDefinitions, map[string]interface{}{"at": "not-a-date"})
+	variableErr, ok := err.(*gqlerrors.Error)
+	if !ok {
+		t.Fatalf("Expected a *gqlerrors.Error, got: %#v", err)
+	}
+	original, ok := gqlerrors.AsError(variableErr).(*gqlerrors.Error)
+	if !ok {
+		t.Fatalf("Expected the original error to be a *gqlerrors.Error, got: %#v", gqlerrors.AsError(variableErr))
+	}
+	expected := `Expected type "DateTime", found "not-a-date".`
+	if original.Message != expected {
+		t.Fatalf("Expected %q, got: %q", expected, original.Message)
+	}
+	if locations := []location.SourceLocation{{Line: 1, Column: 8}}; !reflect.DeepEqual(locations, original.Locations) {
+		t.Fatalf("Expected %v, got: %v", locations, original.Locations)
+	}
+}